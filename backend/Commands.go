@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/mail"
+	"github.com/MishraShardendu22/migrations"
+	"github.com/MishraShardendu22/notifier"
+	"github.com/MishraShardendu22/openapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// runIntegrityCommand backs the -integrity flag. It prints the report as JSON
+// and returns the process exit code: 1 when a check finds issues or anything
+// fails, 0 otherwise.
+func runIntegrityCommand(mode string, dryRun bool) int {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	var (
+		report *database.IntegrityReport
+		err    error
+	)
+	switch mode {
+	case "check":
+		report, err = database.CheckIntegrity(ctx)
+	case "repair":
+		report, err = database.RepairIntegrity(ctx, dryRun)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown -integrity mode %q, expected check or repair\n", mode)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "integrity %s failed: %v\n", mode, err)
+		return 1
+	}
+
+	out, _ := json.MarshalIndent(report, "", "  ")
+	fmt.Println(string(out))
+
+	if mode == "repair" && !dryRun && len(report.Issues) > 0 {
+		// The read cache lives in each server process, out of reach from here
+		fmt.Fprintln(os.Stderr, "running servers may serve cached references until READ_CACHE_TTL_SECONDS passes; "+
+			"flush them with DELETE /api/v1/admin/cache or repair through POST /api/v1/admin/integrity/repair instead")
+	}
+
+	if mode == "check" && len(report.Issues) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// runMigrateCommand backs the -migrate flag and returns the process exit code.
+func runMigrateCommand(action string, steps int, logger *slog.Logger) int {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	var err error
+	switch action {
+	case "up":
+		err = migrations.Up(ctx, logger)
+	case "down":
+		err = migrations.Down(ctx, steps, logger)
+	case "status":
+		var statuses []migrations.Status
+		statuses, err = migrations.List(ctx)
+		if err == nil {
+			out, _ := json.MarshalIndent(statuses, "", "  ")
+			fmt.Println(string(out))
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "unknown -migrate action %q, expected up, down or status\n", action)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate %s failed: %v\n", action, err)
+		return 1
+	}
+	return 0
+}
+
+// runOpenAPICommand backs the -openapi flag. It registers the routes without
+// touching the database. "check" lists routes missing from the document and
+// exits 1 if there are any (TestRoutesDocumented does the same under go test);
+// "print" writes the document to stdout.
+func runOpenAPICommand(action string) int {
+	app := fiber.New()
+	if err := SetUpRoutes(app, slog.Default()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to set up routes: %v\n", err)
+		return 1
+	}
+	routes := app.GetRoutes(true)
+
+	switch action {
+	case "check":
+		missing := openapi.Undocumented(routes, apiV1Prefix)
+		for _, route := range missing {
+			fmt.Fprintf(os.Stderr, "undocumented route: %s\n", route)
+		}
+		if len(missing) > 0 {
+			return 1
+		}
+		fmt.Println("every route is documented")
+		return 0
+	case "print":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(openapi.Build(routes, apiV1Prefix, "Portfolio Backend", "1.0.0")); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write the OpenAPI document: %v\n", err)
+			return 1
+		}
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "unknown -openapi action %q, expected check or print\n", action)
+		return 2
+	}
+}
+
+// runMailTestCommand backs the -mail-test flag. It renders a notification and
+// sends it straight away, skipping the outbox, so SMTP settings can be checked
+// without a database. It returns the process exit code.
+func runMailTestCommand(mailer *mail.Mailer, to string) int {
+	if mailer == nil {
+		fmt.Fprintln(os.Stderr, "email is not configured, set SMTP_HOST and MAIL_FROM")
+		return 2
+	}
+
+	subject, text, html, err := mail.Render(notifier.Notification{
+		Event:   "mail.test",
+		Subject: "Portfolio backend test email",
+		Message: "Email notifications are set up correctly.",
+		SentAt:  time.Now().UTC(),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to render test email: %v\n", err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := mailer.Send(ctx, []string{to}, subject, text, html); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to send test email: %v\n", err)
+		return 1
+	}
+	fmt.Printf("test email sent to %s\n", to)
+	return 0
+}
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/MishraShardendu22/util"
 	"github.com/gofiber/fiber/v2"
 	"golang.org/x/sync/semaphore"
 )
@@ -70,14 +71,14 @@ func FetchLeetCodeData(c *fiber.Ctx) error {
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "request_failed"})
+		return util.ErrorAPI(c, fiber.StatusBadGateway, util.CodeUpstreamFailed, "Upstream request failed", nil)
 	}
 	defer resp.Body.Close()
 
 	respBody, _ := io.ReadAll(resp.Body)
 	var jsonResponse map[string]interface{}
 	if err := json.Unmarshal(respBody, &jsonResponse); err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "invalid_response"})
+		return util.ErrorAPI(c, fiber.StatusBadGateway, util.CodeUpstreamFailed, "Upstream returned an invalid response", nil)
 	}
 
 	return c.JSON(jsonResponse)
@@ -94,14 +95,14 @@ func FetchGitHubProfile(c *fiber.Ctx) error {
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "request_failed"})
+		return util.ErrorAPI(c, fiber.StatusBadGateway, util.CodeUpstreamFailed, "Upstream request failed", nil)
 	}
 	defer resp.Body.Close()
 
 	body, _ := io.ReadAll(resp.Body)
 	var data map[string]interface{}
 	if err := json.Unmarshal(body, &data); err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "invalid_response"})
+		return util.ErrorAPI(c, fiber.StatusBadGateway, util.CodeUpstreamFailed, "Upstream returned an invalid response", nil)
 	}
 
 	return c.JSON(data)
@@ -114,7 +115,7 @@ func FetchGitHubCommits(c *fiber.Ctx) error {
 
 	repos, err := fetchRepos(token, username)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "repo_fetch_failed"})
+		return util.ErrorAPI(c, fiber.StatusBadGateway, util.CodeUpstreamFailed, "Failed to fetch repositories", nil)
 	}
 
 	counts := make(map[string]int)
@@ -178,7 +179,7 @@ func FetchGitHubLanguages(c *fiber.Ctx) error {
 
 	repos, err := fetchRepos(token, username)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "repo_fetch_failed"})
+		return util.ErrorAPI(c, fiber.StatusBadGateway, util.CodeUpstreamFailed, "Failed to fetch repositories", nil)
 	}
 
 	langStats := make(map[string]int)
@@ -227,7 +228,7 @@ func FetchGitHubStars(c *fiber.Ctx) error {
 
 	repos, err := fetchRepos(token, username)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "repo_fetch_failed"})
+		return util.ErrorAPI(c, fiber.StatusBadGateway, util.CodeUpstreamFailed, "Failed to fetch repositories", nil)
 	}
 
 	total := 0
@@ -243,7 +244,7 @@ func FetchTopStarredRepos(c *fiber.Ctx) error {
 
 	repos, err := fetchRepos(token, username)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "repo_fetch_failed"})
+		return util.ErrorAPI(c, fiber.StatusBadGateway, util.CodeUpstreamFailed, "Failed to fetch repositories", nil)
 	}
 
 	sort.Slice(repos, func(i, j int) bool {
@@ -271,7 +272,7 @@ func FetchContributionCalendar(c *fiber.Ctx) error {
 
 	resp, err := httpClient.Get(url)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "calendar_fetch_failed"})
+		return util.ErrorAPI(c, fiber.StatusBadGateway, util.CodeUpstreamFailed, "Failed to fetch contribution calendar", nil)
 	}
 	defer resp.Body.Close()
 
@@ -0,0 +1,197 @@
+package analytics
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// View is one successful request for a piece of content, or a click on one
+// of its outbound links when Link is set.
+type View struct {
+	Entity   string
+	EntityID primitive.ObjectID
+	// Link is the JSON name of the field holding the clicked URL.
+	Link      string
+	IP        string
+	UserAgent string
+	// Referrer is the referring URL; only its host is stored.
+	Referrer string
+	At       time.Time
+}
+
+type Options struct {
+	// Disabled drops every view.
+	Disabled bool
+	// OwnHosts are the frontend's hosts; referrers from them are recorded
+	// as internal navigation.
+	OwnHosts []string
+	Logger   *slog.Logger
+}
+
+// queueSize is how many views may wait to be stored; more are dropped
+// rather than slowing down the requests that produce them.
+const queueSize = 1024
+
+// Recorder stores views in the background, so counting a view never delays
+// or fails the request it came from.
+type Recorder struct {
+	opts  Options
+	queue chan View
+
+	mu    sync.Mutex
+	salts map[string]string
+}
+
+func New(opts Options) *Recorder {
+	if opts.Logger == nil {
+		opts.Logger = slog.Default()
+	}
+	return &Recorder{opts: opts, queue: make(chan View, queueSize), salts: make(map[string]string)}
+}
+
+// Default is the recorder the view middleware feeds.
+var Default = New(Options{})
+
+// Start stores queued views until ctx is cancelled.
+func (r *Recorder) Start(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v := <-r.queue:
+				if err := r.store(ctx, v); err != nil && ctx.Err() == nil {
+					r.opts.Logger.Error("Failed to record view", "entity", v.Entity, "id", v.EntityID.Hex(), "error", err)
+				}
+			}
+		}
+	}()
+}
+
+// Track queues v unless it comes from a bot, the recorder is disabled or the
+// queue is full.
+func (r *Recorder) Track(v View) {
+	if r.opts.Disabled || IsBot(v.UserAgent) {
+		return
+	}
+	select {
+	case r.queue <- v:
+	default:
+	}
+}
+
+// store counts v as a view, and as a visitor unless the same visitor has
+// already seen the content that day.
+func (r *Recorder) store(ctx context.Context, v View) error {
+	day := Day(v.At)
+	salt, err := r.salt(ctx, day)
+	if err != nil {
+		return err
+	}
+	visitor := visitorHash(salt, v.IP, v.UserAgent)
+	if v.Link != "" {
+		return storeClick(ctx, day, visitor, v)
+	}
+
+	visit := &models.ViewVisitor{Day: day, Entity: v.Entity, EntityID: v.EntityID, Visitor: visitor}
+	newVisitor := true
+	if err := mgm.Coll(visit).CreateWithCtx(ctx, visit); mongo.IsDuplicateKeyError(err) {
+		newVisitor = false
+	} else if err != nil {
+		return err
+	}
+
+	content := bson.M{"day": day, "entity": v.Entity, "entity_id": v.EntityID}
+	inc := bson.M{"views": 1}
+	if newVisitor {
+		inc["visitors"] = 1
+	}
+	if err := upsertCount(ctx, &models.ContentViewDay{}, content, inc); err != nil {
+		return err
+	}
+
+	if !newVisitor {
+		return nil
+	}
+	content["referrer"] = ReferrerHost(v.Referrer, r.opts.OwnHosts)
+	return upsertCount(ctx, &models.ReferrerViewDay{}, content, bson.M{"visitors": 1})
+}
+
+// storeClick counts a click on v.Link, and a visitor unless the same visitor
+// already clicked it that day.
+func storeClick(ctx context.Context, day, visitor string, v View) error {
+	click := &models.LinkClickVisitor{Day: day, Entity: v.Entity, EntityID: v.EntityID, Link: v.Link, Visitor: visitor}
+	inc := bson.M{"clicks": 1, "visitors": 1}
+	if err := mgm.Coll(click).CreateWithCtx(ctx, click); mongo.IsDuplicateKeyError(err) {
+		delete(inc, "visitors")
+	} else if err != nil {
+		return err
+	}
+
+	link := bson.M{"day": day, "entity": v.Entity, "entity_id": v.EntityID, "link": v.Link}
+	return upsertCount(ctx, &models.LinkClickDay{}, link, inc)
+}
+
+// upsertCount increments counters on the daily document matching filter,
+// creating it on the first view.
+func upsertCount(ctx context.Context, model mgm.Model, filter, inc bson.M) error {
+	now := time.Now()
+	update := bson.M{
+		"$inc":         inc,
+		"$set":         bson.M{"updated_at": now},
+		"$setOnInsert": bson.M{"created_at": now},
+	}
+	_, err := mgm.Coll(model).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// salt returns the salt for day, creating it on first use. It is stored so
+// that every instance hashes the same visitor the same way.
+func (r *Recorder) salt(ctx context.Context, day string) (string, error) {
+	r.mu.Lock()
+	salt, ok := r.salts[day]
+	r.mu.Unlock()
+	if ok {
+		return salt, nil
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	now := time.Now()
+	update := bson.M{"$setOnInsert": bson.M{"day": day, "salt": hex.EncodeToString(b), "created_at": now, "updated_at": now}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var stored models.ViewSalt
+	err := mgm.Coll(&stored).FindOneAndUpdate(ctx, bson.M{"day": day}, update, opts).Decode(&stored)
+	if mongo.IsDuplicateKeyError(err) {
+		// Another instance created it at the same moment
+		err = mgm.Coll(&stored).FirstWithCtx(ctx, bson.M{"day": day}, &stored)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	// Only today's salt is needed from now on; older ones are forgotten
+	r.salts = map[string]string{day: stored.Salt}
+	r.mu.Unlock()
+	return stored.Salt, nil
+}
+
+// Day is the UTC day t falls on, as stored in the daily counts.
+func Day(t time.Time) string {
+	return t.UTC().Format(time.DateOnly)
+}
@@ -0,0 +1,280 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Query selects the days From to To (inclusive, YYYY-MM-DD) and optionally
+// one content type, or one piece of content when EntityID is set too.
+type Query struct {
+	From     string
+	To       string
+	Entity   string
+	EntityID *primitive.ObjectID
+	Limit    int
+}
+
+func (q Query) match() bson.M {
+	filter := bson.M{"day": bson.M{"$gte": q.From, "$lte": q.To}}
+	if q.Entity != "" {
+		filter["entity"] = q.Entity
+	}
+	if q.EntityID != nil {
+		filter["entity_id"] = *q.EntityID
+	}
+	return filter
+}
+
+type TopContent struct {
+	Entity   string `json:"entity"`
+	EntityID string `json:"entity_id"`
+	// Title is empty once the content has been deleted.
+	Title    string `json:"title"`
+	Views    int    `json:"views"`
+	Visitors int    `json:"visitors"`
+}
+
+type LinkClicks struct {
+	Entity   string `json:"entity"`
+	EntityID string `json:"entity_id"`
+	Title    string `json:"title"`
+	// Link is the JSON name of the field holding the URL.
+	Link     string `json:"link"`
+	Clicks   int    `json:"clicks"`
+	Visitors int    `json:"visitors"`
+}
+
+type DayCount struct {
+	Day      string `json:"day"`
+	Views    int    `json:"views"`
+	Visitors int    `json:"visitors"`
+}
+
+type ReferrerCount struct {
+	Referrer string `json:"referrer"`
+	Visitors int    `json:"visitors"`
+}
+
+// Top returns the most visited content, by visitors and then views.
+func Top(ctx context.Context, q Query) ([]TopContent, error) {
+	pipeline := bson.A{
+		bson.M{"$match": q.match()},
+		bson.M{"$group": bson.M{
+			"_id":      bson.M{"entity": "$entity", "entity_id": "$entity_id"},
+			"views":    bson.M{"$sum": "$views"},
+			"visitors": bson.M{"$sum": "$visitors"},
+		}},
+		bson.M{"$sort": bson.D{{Key: "visitors", Value: -1}, {Key: "views", Value: -1}}},
+		bson.M{"$limit": q.Limit},
+	}
+
+	var rows []struct {
+		ID struct {
+			Entity   string             `bson:"entity"`
+			EntityID primitive.ObjectID `bson:"entity_id"`
+		} `bson:"_id"`
+		Views    int `bson:"views"`
+		Visitors int `bson:"visitors"`
+	}
+	cursor, err := mgm.Coll(&models.ContentViewDay{}).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	ids := map[string][]primitive.ObjectID{}
+	for _, row := range rows {
+		ids[row.ID.Entity] = append(ids[row.ID.Entity], row.ID.EntityID)
+	}
+	titles, err := contentTitles(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	top := make([]TopContent, len(rows))
+	for i, row := range rows {
+		top[i] = TopContent{
+			Entity:   row.ID.Entity,
+			EntityID: row.ID.EntityID.Hex(),
+			Title:    titles[row.ID.EntityID],
+			Views:    row.Views,
+			Visitors: row.Visitors,
+		}
+	}
+	return top, nil
+}
+
+// Trend returns the views and visitors of every day in the range, with
+// zeros for days without views.
+func Trend(ctx context.Context, q Query) ([]DayCount, error) {
+	pipeline := bson.A{
+		bson.M{"$match": q.match()},
+		bson.M{"$group": bson.M{
+			"_id":      "$day",
+			"views":    bson.M{"$sum": "$views"},
+			"visitors": bson.M{"$sum": "$visitors"},
+		}},
+	}
+
+	var rows []struct {
+		Day      string `bson:"_id"`
+		Views    int    `bson:"views"`
+		Visitors int    `bson:"visitors"`
+	}
+	cursor, err := mgm.Coll(&models.ContentViewDay{}).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	byDay := make(map[string]DayCount, len(rows))
+	for _, row := range rows {
+		byDay[row.Day] = DayCount{Day: row.Day, Views: row.Views, Visitors: row.Visitors}
+	}
+
+	from, _ := time.Parse(time.DateOnly, q.From)
+	to, _ := time.Parse(time.DateOnly, q.To)
+	var trend []DayCount
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		key := Day(day)
+		count, ok := byDay[key]
+		if !ok {
+			count = DayCount{Day: key}
+		}
+		trend = append(trend, count)
+	}
+	return trend, nil
+}
+
+// Referrers returns the sites that sent the most visitors.
+func Referrers(ctx context.Context, q Query) ([]ReferrerCount, error) {
+	pipeline := bson.A{
+		bson.M{"$match": q.match()},
+		bson.M{"$group": bson.M{"_id": "$referrer", "visitors": bson.M{"$sum": "$visitors"}}},
+		bson.M{"$sort": bson.D{{Key: "visitors", Value: -1}, {Key: "_id", Value: 1}}},
+		bson.M{"$limit": q.Limit},
+	}
+
+	var rows []struct {
+		Referrer string `bson:"_id"`
+		Visitors int    `bson:"visitors"`
+	}
+	cursor, err := mgm.Coll(&models.ReferrerViewDay{}).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	referrers := make([]ReferrerCount, len(rows))
+	for i, row := range rows {
+		referrers[i] = ReferrerCount{Referrer: row.Referrer, Visitors: row.Visitors}
+	}
+	return referrers, nil
+}
+
+// Links returns the most clicked outbound links, by clicks and then
+// visitors.
+func Links(ctx context.Context, q Query) ([]LinkClicks, error) {
+	pipeline := bson.A{
+		bson.M{"$match": q.match()},
+		bson.M{"$group": bson.M{
+			"_id":      bson.M{"entity": "$entity", "entity_id": "$entity_id", "link": "$link"},
+			"clicks":   bson.M{"$sum": "$clicks"},
+			"visitors": bson.M{"$sum": "$visitors"},
+		}},
+		bson.M{"$sort": bson.D{{Key: "clicks", Value: -1}, {Key: "visitors", Value: -1}}},
+		bson.M{"$limit": q.Limit},
+	}
+
+	var rows []struct {
+		ID struct {
+			Entity   string             `bson:"entity"`
+			EntityID primitive.ObjectID `bson:"entity_id"`
+			Link     string             `bson:"link"`
+		} `bson:"_id"`
+		Clicks   int `bson:"clicks"`
+		Visitors int `bson:"visitors"`
+	}
+	cursor, err := mgm.Coll(&models.LinkClickDay{}).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	ids := map[string][]primitive.ObjectID{}
+	for _, row := range rows {
+		ids[row.ID.Entity] = append(ids[row.ID.Entity], row.ID.EntityID)
+	}
+	titles, err := contentTitles(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]LinkClicks, len(rows))
+	for i, row := range rows {
+		links[i] = LinkClicks{
+			Entity:   row.ID.Entity,
+			EntityID: row.ID.EntityID.Hex(),
+			Title:    titles[row.ID.EntityID],
+			Link:     row.ID.Link,
+			Clicks:   row.Clicks,
+			Visitors: row.Visitors,
+		}
+	}
+	return links, nil
+}
+
+// contentTitles loads a display title for each ID, grouped by content type.
+func contentTitles(ctx context.Context, ids map[string][]primitive.ObjectID) (map[primitive.ObjectID]string, error) {
+	titles := map[primitive.ObjectID]string{}
+
+	if len(ids[models.ViewProject]) > 0 {
+		var projects []models.Project
+		opts := options.Find().SetProjection(bson.M{"project_name": 1})
+		if err := mgm.Coll(&models.Project{}).SimpleFindWithCtx(ctx, &projects, bson.M{"_id": bson.M{"$in": ids[models.ViewProject]}}, opts); err != nil {
+			return nil, err
+		}
+		for _, p := range projects {
+			titles[p.ID] = p.ProjectName
+		}
+	}
+
+	if len(ids[models.ViewExperience]) > 0 {
+		var exps []models.Experience
+		opts := options.Find().SetProjection(bson.M{"company_name": 1, "position": 1})
+		if err := mgm.Coll(&models.Experience{}).SimpleFindWithCtx(ctx, &exps, bson.M{"_id": bson.M{"$in": ids[models.ViewExperience]}}, opts); err != nil {
+			return nil, err
+		}
+		for _, e := range exps {
+			titles[e.ID] = e.Position + " at " + e.CompanyName
+		}
+	}
+
+	if len(ids[models.ViewCertification]) > 0 {
+		var certs []models.CertificationOrAchievements
+		opts := options.Find().SetProjection(bson.M{"title": 1})
+		if err := mgm.Coll(&models.CertificationOrAchievements{}).SimpleFindWithCtx(ctx, &certs, bson.M{"_id": bson.M{"$in": ids[models.ViewCertification]}}, opts); err != nil {
+			return nil, err
+		}
+		for _, c := range certs {
+			titles[c.ID] = c.Title
+		}
+	}
+
+	return titles, nil
+}
@@ -0,0 +1,65 @@
+package analytics
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Referrers that are not another site.
+const (
+	DirectReferrer   = "(direct)"
+	InternalReferrer = "(internal)"
+)
+
+// botAgent matches crawlers, link previews, monitors and HTTP libraries.
+var botAgent = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|archiver|preview|facebookexternalhit|embedly|` +
+	`headless|lighthouse|pagespeed|pingdom|uptime|monitor|curl|wget|httpie|python-|go-http-client|java/|okhttp|` +
+	`axios|node-fetch|undici|postman|insomnia`)
+
+// IsBot reports whether userAgent should not be counted. Browsers always
+// send a user agent, so an empty one is treated as a bot too.
+func IsBot(userAgent string) bool {
+	return strings.TrimSpace(userAgent) == "" || botAgent.MatchString(userAgent)
+}
+
+// visitorHash identifies a visitor for one day without storing their
+// address: once the day's salt is deleted the hash cannot be reversed by
+// trying every IP address.
+func visitorHash(salt, ip, userAgent string) string {
+	sum := sha256.Sum256([]byte(salt + "|" + ip + "|" + userAgent))
+	return hex.EncodeToString(sum[:16])
+}
+
+// ReferrerHost reduces a referring URL to its host, so no paths or query
+// strings are stored. Hosts in ownHosts count as internal navigation.
+func ReferrerHost(referrer string, ownHosts []string) string {
+	u, err := url.Parse(strings.TrimSpace(referrer))
+	if err != nil || u.Hostname() == "" {
+		return DirectReferrer
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	for _, own := range ownHosts {
+		if host == own {
+			return InternalReferrer
+		}
+	}
+	return host
+}
+
+// OwnHosts returns the hosts of a comma-separated origin list such as
+// CORS_ALLOW_ORIGINS, in the form ReferrerHost compares against.
+func OwnHosts(origins string) []string {
+	var hosts []string
+	for _, origin := range strings.Split(origins, ",") {
+		u, err := url.Parse(strings.TrimSpace(origin))
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		hosts = append(hosts, strings.TrimPrefix(strings.ToLower(u.Hostname()), "www."))
+	}
+	return hosts
+}
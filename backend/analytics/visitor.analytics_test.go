@@ -0,0 +1,71 @@
+package analytics
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIsBot(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		want      bool
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0 Safari/537.36", false},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1", false},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:127.0) Gecko/20100101 Firefox/127.0", false},
+		{"", true},
+		{"   ", true},
+		{"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", true},
+		{"Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)", true},
+		{"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)", true},
+		{"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) HeadlessChrome/126.0 Safari/537.36", true},
+		{"Mozilla/5.0 (Linux; Android 11) Chrome-Lighthouse", true},
+		{"UptimeRobot/2.0", true},
+		{"curl/8.5.0", true},
+		{"Wget/1.21", true},
+		{"python-requests/2.32", true},
+		{"Go-http-client/1.1", true},
+		{"axios/1.7.2", true},
+		{"PostmanRuntime/7.39", true},
+	}
+
+	for _, tt := range tests {
+		if got := IsBot(tt.userAgent); got != tt.want {
+			t.Errorf("IsBot(%q) = %v, want %v", tt.userAgent, got, tt.want)
+		}
+	}
+}
+
+func TestReferrerHost(t *testing.T) {
+	own := []string{"example.dev", "localhost"}
+
+	tests := []struct {
+		referrer string
+		want     string
+	}{
+		{"", DirectReferrer},
+		{"not a url", DirectReferrer},
+		{"/projects", DirectReferrer},
+		{"https://news.ycombinator.com/item?id=1", "news.ycombinator.com"},
+		{"https://WWW.Google.com/search?q=portfolio", "google.com"},
+		{"http://github.com:443/someone", "github.com"},
+		{"https://example.dev/projects/1", InternalReferrer},
+		{"https://www.example.dev/", InternalReferrer},
+		{"http://localhost:3000/", InternalReferrer},
+		// Subdomains are other sites
+		{"https://blog.example.dev/", "blog.example.dev"},
+	}
+
+	for _, tt := range tests {
+		if got := ReferrerHost(tt.referrer, own); got != tt.want {
+			t.Errorf("ReferrerHost(%q) = %q, want %q", tt.referrer, got, tt.want)
+		}
+	}
+}
+
+func TestOwnHosts(t *testing.T) {
+	got := OwnHosts("https://www.Example.dev, http://localhost:3000,,not a url")
+	if want := []string{"example.dev", "localhost"}; !slices.Equal(got, want) {
+		t.Errorf("OwnHosts = %v, want %v", got, want)
+	}
+}
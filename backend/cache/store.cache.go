@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Tags group cached responses by the content they were built from, so a
+// write can drop exactly the responses it affects.
+const (
+	TagProjects       = "projects"
+	TagExperiences    = "experiences"
+	TagCertifications = "certifications"
+	TagSkills         = "skills"
+	// TagRelations covers responses that join several content types.
+	TagRelations = "relations"
+	// TagStats covers the GitHub and LeetCode responses, which only expire.
+	TagStats = "stats"
+)
+
+type Entry struct {
+	Status       int
+	Body         []byte
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+	CacheControl string
+	// Expires, when set, ends the entry before the TTL would.
+	Expires   time.Time
+	tags      []string
+	storedAt  time.Time
+	expiresAt time.Time
+}
+
+type Stats struct {
+	Enabled       bool    `json:"enabled"`
+	Entries       int     `json:"entries"`
+	MaxEntries    int     `json:"max_entries"`
+	TTLSeconds    float64 `json:"ttl_seconds"`
+	Hits          int64   `json:"hits"`
+	Misses        int64   `json:"misses"`
+	HitRatio      float64 `json:"hit_ratio"`
+	Invalidations int64   `json:"invalidations"`
+	Evictions     int64   `json:"evictions"`
+}
+
+// Store is an in-memory response cache safe for concurrent use. A zero TTL
+// disables it: Get always misses and Set is a no-op.
+type Store struct {
+	mu         sync.RWMutex
+	entries    map[string]*Entry
+	byTag      map[string]map[string]struct{}
+	ttl        time.Duration
+	maxEntries int
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	invalidations atomic.Int64
+	evictions     atomic.Int64
+}
+
+func New(ttl time.Duration, maxEntries int) *Store {
+	return &Store{
+		entries:    make(map[string]*Entry),
+		byTag:      make(map[string]map[string]struct{}),
+		ttl:        ttl,
+		maxEntries: maxEntries,
+	}
+}
+
+// Default is the store shared by the read cache middleware and the admin endpoints.
+var Default = New(10*time.Minute, 1000)
+
+func (s *Store) Enabled() bool {
+	return s.ttl > 0
+}
+
+func (s *Store) Get(key string) (*Entry, bool) {
+	if !s.Enabled() {
+		return nil, false
+	}
+
+	s.mu.RLock()
+	entry, ok := s.entries[key]
+	s.mu.RUnlock()
+
+	if !ok || time.Now().After(entry.expiresAt) {
+		s.misses.Add(1)
+		return nil, false
+	}
+	s.hits.Add(1)
+	return entry, true
+}
+
+func (s *Store) Set(key string, entry Entry, tags ...string) {
+	if !s.Enabled() {
+		return
+	}
+
+	now := time.Now()
+	entry.tags = tags
+	entry.storedAt = now
+	entry.expiresAt = now.Add(s.ttl)
+	if !entry.Expires.IsZero() && entry.Expires.Before(entry.expiresAt) {
+		entry.expiresAt = entry.Expires
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.entries[key]; !exists && s.maxEntries > 0 && len(s.entries) >= s.maxEntries {
+		s.evictLocked(now)
+	}
+
+	s.removeLocked(key)
+	s.entries[key] = &entry
+	for _, tag := range tags {
+		if s.byTag[tag] == nil {
+			s.byTag[tag] = make(map[string]struct{})
+		}
+		s.byTag[tag][key] = struct{}{}
+	}
+}
+
+// Invalidate drops every entry carrying any of tags and returns how many were dropped.
+func (s *Store) Invalidate(tags ...string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dropped := 0
+	for _, tag := range tags {
+		for key := range s.byTag[tag] {
+			if s.removeLocked(key) {
+				dropped++
+			}
+		}
+	}
+	s.invalidations.Add(int64(dropped))
+	return dropped
+}
+
+// Flush drops every entry and returns how many were dropped.
+func (s *Store) Flush() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dropped := len(s.entries)
+	s.entries = make(map[string]*Entry)
+	s.byTag = make(map[string]map[string]struct{})
+	s.invalidations.Add(int64(dropped))
+	return dropped
+}
+
+func (s *Store) Stats() Stats {
+	s.mu.RLock()
+	entries := len(s.entries)
+	s.mu.RUnlock()
+
+	hits, misses := s.hits.Load(), s.misses.Load()
+	ratio := 0.0
+	if hits+misses > 0 {
+		ratio = float64(hits) / float64(hits+misses)
+	}
+
+	return Stats{
+		Enabled:       s.Enabled(),
+		Entries:       entries,
+		MaxEntries:    s.maxEntries,
+		TTLSeconds:    s.ttl.Seconds(),
+		Hits:          hits,
+		Misses:        misses,
+		HitRatio:      ratio,
+		Invalidations: s.invalidations.Load(),
+		Evictions:     s.evictions.Load(),
+	}
+}
+
+func (s *Store) removeLocked(key string) bool {
+	entry, ok := s.entries[key]
+	if !ok {
+		return false
+	}
+	for _, tag := range entry.tags {
+		delete(s.byTag[tag], key)
+	}
+	delete(s.entries, key)
+	return true
+}
+
+// evictLocked makes room for one entry: expired entries go first, otherwise
+// the oldest one.
+func (s *Store) evictLocked(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range s.entries {
+		if now.After(entry.expiresAt) {
+			s.removeLocked(key)
+			s.evictions.Add(1)
+			continue
+		}
+		if oldestKey == "" || entry.storedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.storedAt
+		}
+	}
+
+	if len(s.entries) >= s.maxEntries && oldestKey != "" {
+		s.removeLocked(oldestKey)
+		s.evictions.Add(1)
+	}
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestStoreInvalidate(t *testing.T) {
+	entries := []struct {
+		key  string
+		tags []string
+	}{
+		{"/projects", []string{TagProjects}},
+		{"/projects/1/related", []string{TagRelations}},
+		{"/experiences", []string{TagExperiences}},
+		{"/skills", []string{TagSkills}},
+		{"/home", []string{TagProjects, TagSkills}},
+	}
+
+	tests := []struct {
+		name        string
+		invalidate  []string
+		wantDropped int
+		wantKept    []string
+	}{
+		{"one tag", []string{TagExperiences}, 1, []string{"/home", "/projects", "/projects/1/related", "/skills"}},
+		{"several tags", []string{TagProjects, TagRelations}, 3, []string{"/experiences", "/skills"}},
+		// An entry under two of the tags is only counted once
+		{"shared entry", []string{TagProjects, TagSkills}, 3, []string{"/experiences", "/projects/1/related"}},
+		{"unused tag", []string{TagCertifications}, 0, []string{"/experiences", "/home", "/projects", "/projects/1/related", "/skills"}},
+		{"no tags", nil, 0, []string{"/experiences", "/home", "/projects", "/projects/1/related", "/skills"}},
+	}
+
+	for _, tt := range tests {
+		s := New(time.Minute, 0)
+		for _, e := range entries {
+			s.Set(e.key, Entry{Status: 200}, e.tags...)
+		}
+
+		if dropped := s.Invalidate(tt.invalidate...); dropped != tt.wantDropped {
+			t.Errorf("%s: Invalidate dropped %d, want %d", tt.name, dropped, tt.wantDropped)
+		}
+
+		var kept []string
+		for _, e := range entries {
+			if _, ok := s.Get(e.key); ok {
+				kept = append(kept, e.key)
+			}
+		}
+		slices.Sort(kept)
+		if !slices.Equal(kept, tt.wantKept) {
+			t.Errorf("%s: kept %v, want %v", tt.name, kept, tt.wantKept)
+		}
+	}
+}
+
+func TestStoreSetReplacesTags(t *testing.T) {
+	s := New(time.Minute, 0)
+	s.Set("/projects", Entry{}, TagProjects)
+	s.Set("/projects", Entry{}, TagSkills)
+
+	if dropped := s.Invalidate(TagProjects); dropped != 0 {
+		t.Errorf("old tag dropped %d entries, want 0", dropped)
+	}
+	if dropped := s.Invalidate(TagSkills); dropped != 1 {
+		t.Errorf("new tag dropped %d entries, want 1", dropped)
+	}
+}
+
+func TestStoreExpires(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		ttl     time.Duration
+		expires time.Time
+		want    bool
+	}{
+		{"within the TTL", time.Minute, time.Time{}, true},
+		{"expires later than the TTL", time.Minute, now.Add(time.Hour), true},
+		{"already expired", time.Minute, now.Add(-time.Second), false},
+		{"disabled", 0, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		s := New(tt.ttl, 0)
+		s.Set("/certifications", Entry{Expires: tt.expires}, TagCertifications)
+		if _, ok := s.Get("/certifications"); ok != tt.want {
+			t.Errorf("%s: Get hit = %v, want %v", tt.name, ok, tt.want)
+		}
+	}
+}
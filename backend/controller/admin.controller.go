@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"github.com/MishraShardendu22/cache"
+	"github.com/MishraShardendu22/database"
 	"github.com/MishraShardendu22/models"
 	"github.com/MishraShardendu22/util"
 	"github.com/gofiber/fiber/v2"
@@ -9,43 +11,45 @@ import (
 )
 
 func AdminRegisterAndLogin(c *fiber.Ctx, adminPass string, secret string) error {
-	var req models.User
+	var login models.AdminLoginRequest
 
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.BodyParser(&login); err != nil {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid request body", nil, "")
 	}
 
-	if req.AdminPass != adminPass {
-		return util.ResponseAPI(c, fiber.StatusUnauthorized, "Invalid admin password", nil, "")
+	// Check the admin password first so validation details are not
+	// disclosed to unauthenticated callers
+	if login.AdminPass != adminPass {
+		return util.ErrorAPI(c, fiber.StatusUnauthorized, util.CodeInvalidCredentials, "Invalid admin password", nil)
 	}
 
-	req.AdminPass = ""
-
-	if req.Email == "" || req.Password == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "email and password are required", nil, "")
+	if fields := util.Validate(login); fields != nil {
+		return util.ErrorAPI(c, fiber.StatusUnprocessableEntity, util.CodeValidationFailed, "Validation failed", fields)
 	}
 
+	req := models.User{Email: login.Email, Password: login.Password}
+
 	existing := &models.User{}
 	err := mgm.Coll(existing).First(bson.M{"email": req.Email}, existing)
 	if err == nil {
 		// User exists - verify password
 		if !util.CheckPassword(req.Password, existing.Password) {
-			return util.ResponseAPI(c, fiber.StatusUnauthorized, "Invalid email or password", nil, "")
+			return util.ErrorAPI(c, fiber.StatusUnauthorized, util.CodeInvalidCredentials, "Invalid email or password", nil)
 		}
 
 		token, _ := util.GenerateJWT(existing.ID.Hex(), existing.Email, secret)
-		return util.ResponseAPI(c, fiber.StatusAccepted, "User already exists", existing, token)
+		return util.ResponseAPI(c, fiber.StatusAccepted, "User already exists", existing.Response(), token)
 	}
 
 	// User not found - create new
 	req.Password = util.HashPassword(req.Password)
 	if err := mgm.Coll(&req).Create(&req); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to register admin", err.Error(), "")
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to register admin", nil, "")
 	}
 
 	token, _ := util.GenerateJWT(req.ID.Hex(), req.Email, secret)
 
-	return util.ResponseAPI(c, fiber.StatusCreated, "Admin registered successfully", req, token)
+	return util.ResponseAPI(c, fiber.StatusCreated, "Admin registered successfully", req.Response(), token)
 }
 
 func AdminGet(c *fiber.Ctx) error {
@@ -60,6 +64,56 @@ func AdminGet(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
 	}
 
-	user.Password = "" // Don't return password hash
-	return util.ResponseAPI(c, fiber.StatusOK, "User profile fetched successfully", user, "")
-}
\ No newline at end of file
+	return util.ResponseAPI(c, fiber.StatusOK, "User profile fetched successfully", user.Response(), "")
+}
+
+func AdminIntegrityCheck(c *fiber.Ctx) error {
+	report, err := database.CheckIntegrity(c.Context())
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to check data integrity", nil, "")
+	}
+
+	return util.ResponseAPI(c, fiber.StatusOK, "Integrity check completed", report, "")
+}
+
+func AdminIntegrityRepair(c *fiber.Ctx) error {
+	dryRun := c.QueryBool("dry_run", false)
+
+	report, err := database.RepairIntegrity(c.Context(), dryRun)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to repair data integrity", nil, "")
+	}
+
+	message := "Integrity repair completed"
+	if dryRun {
+		message = "Integrity repair dry run completed"
+	} else {
+		cache.Default.Flush()
+		publishRewritten(c.Context(), report.Rewritten())
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, message, report, "")
+}
+
+func AdminIndexStats(c *fiber.Ctx) error {
+	stats, err := database.IndexStats(c.Context())
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch index statistics", nil, "")
+	}
+
+	return util.ResponseAPI(c, fiber.StatusOK, "Index statistics retrieved successfully", stats, "")
+}
+
+func AdminCacheStats(c *fiber.Ctx) error {
+	return util.ResponseAPI(c, fiber.StatusOK, "Cache statistics retrieved successfully", cache.Default.Stats(), "")
+}
+
+func AdminCacheFlush(c *fiber.Ctx) error {
+	var dropped int
+	if tag := c.Query("tag"); tag != "" {
+		dropped = cache.Default.Invalidate(tag)
+	} else {
+		dropped = cache.Default.Flush()
+	}
+
+	return util.ResponseAPI(c, fiber.StatusOK, "Cache flushed successfully", fiber.Map{"dropped": dropped}, "")
+}
@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/MishraShardendu22/analytics"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// analyticsQuery reads the `days`, `entity`, `id` and `limit` parameters
+// shared by the analytics reports. When ok is false the error response has
+// already been written.
+func analyticsQuery(c *fiber.Ctx, defaultLimit int) (q analytics.Query, ok bool, err error) {
+	days := c.QueryInt("days", 30)
+	if days < 1 || days > 365 {
+		return q, false, util.ResponseAPI(c, fiber.StatusBadRequest, "days must be between 1 and 365", nil, "")
+	}
+	now := time.Now()
+	q.From = analytics.Day(now.AddDate(0, 0, 1-days))
+	q.To = analytics.Day(now)
+
+	switch q.Entity = c.Query("entity"); q.Entity {
+	case "", models.ViewProject, models.ViewExperience, models.ViewCertification:
+	default:
+		return q, false, util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid entity, expected project, experience or certification", nil, "")
+	}
+
+	if hex := c.Query("id"); hex != "" {
+		if q.Entity == "" {
+			return q, false, util.ResponseAPI(c, fiber.StatusBadRequest, "id requires entity", nil, "")
+		}
+		id, err := primitive.ObjectIDFromHex(hex)
+		if err != nil {
+			return q, false, util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid id", nil, "")
+		}
+		q.EntityID = &id
+	}
+
+	q.Limit = c.QueryInt("limit", defaultLimit)
+	if q.Limit < 1 || q.Limit > 100 {
+		return q, false, util.ResponseAPI(c, fiber.StatusBadRequest, "limit must be between 1 and 100", nil, "")
+	}
+	return q, true, nil
+}
+
+func AdminTopContent(c *fiber.Ctx) error {
+	q, ok, err := analyticsQuery(c, 10)
+	if !ok {
+		return err
+	}
+
+	top, err := analytics.Top(c.Context(), q)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to load top content", nil, "")
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, "Top content retrieved successfully", top, "")
+}
+
+func AdminViewTrends(c *fiber.Ctx) error {
+	q, ok, err := analyticsQuery(c, 10)
+	if !ok {
+		return err
+	}
+
+	trend, err := analytics.Trend(c.Context(), q)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to load view trends", nil, "")
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, "View trends retrieved successfully", trend, "")
+}
+
+func AdminReferrers(c *fiber.Ctx) error {
+	q, ok, err := analyticsQuery(c, 20)
+	if !ok {
+		return err
+	}
+
+	referrers, err := analytics.Referrers(c.Context(), q)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to load referrers", nil, "")
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, "Referrers retrieved successfully", referrers, "")
+}
+
+func AdminLinkClicks(c *fiber.Ctx) error {
+	q, ok, err := analyticsQuery(c, 20)
+	if !ok {
+		return err
+	}
+
+	links, err := analytics.Links(c.Context(), q)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to load link clicks", nil, "")
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, "Link clicks retrieved successfully", links, "")
+}
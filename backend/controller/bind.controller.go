@@ -0,0 +1,19 @@
+package controller
+
+import (
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+)
+
+// bind parses the request body into req and validates it. When ok is false
+// the 400 or 422 response has already been written and err must be returned.
+func bind(c *fiber.Ctx, req any) (ok bool, err error) {
+	if err := c.BodyParser(req); err != nil {
+		return false, util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid request body", nil, "")
+	}
+
+	if fields := util.Validate(req); fields != nil {
+		return false, util.ErrorAPI(c, fiber.StatusUnprocessableEntity, util.CodeValidationFailed, "Validation failed", fields)
+	}
+	return true, nil
+}
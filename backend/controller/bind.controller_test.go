@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestBind(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantCode   string
+		wantFields []string
+	}{
+		{"valid", `{"skills":["go"]}`, fiber.StatusOK, "", nil},
+		{"malformed", `{"skills":`, fiber.StatusBadRequest, "", nil},
+		{"missing field", `{}`, fiber.StatusUnprocessableEntity, util.CodeValidationFailed, []string{"skills"}},
+		{"invalid element", `{"skills":["go",""]}`, fiber.StatusUnprocessableEntity, util.CodeValidationFailed, []string{"skills[1]"}},
+	}
+
+	for _, tt := range tests {
+		app := fiber.New()
+		app.Post("/", func(c *fiber.Ctx) error {
+			var req models.SkillsRequest
+			if ok, err := bind(c, &req); !ok {
+				return err
+			}
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		req := httptest.NewRequest(fiber.MethodPost, "/", strings.NewReader(tt.body))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Errorf("%s: status = %d, want %d", tt.name, resp.StatusCode, tt.wantStatus)
+			continue
+		}
+		if tt.wantCode == "" {
+			continue
+		}
+
+		var body struct {
+			Error struct {
+				Code    string            `json:"code"`
+				Details []util.FieldError `json:"details"`
+			} `json:"error"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if body.Error.Code != tt.wantCode {
+			t.Errorf("%s: code = %q, want %q", tt.name, body.Error.Code, tt.wantCode)
+		}
+		var fields []string
+		for _, f := range body.Error.Details {
+			fields = append(fields, f.Field)
+		}
+		if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+			t.Errorf("%s: fields = %v, want %v", tt.name, fields, tt.wantFields)
+		}
+	}
+}
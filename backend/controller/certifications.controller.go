@@ -1,6 +1,13 @@
 package controller
 
 import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/events"
 	"github.com/MishraShardendu22/models"
 	"github.com/MishraShardendu22/util"
 	"github.com/gofiber/fiber/v2"
@@ -9,11 +16,62 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// CertificationExpiryWindowDays is how close to its expiry date a
+// certification has to be before it is reported as expiring soon.
+var CertificationExpiryWindowDays = 30
+
+// certificationETag is the version ETag of a certification read on now's UTC
+// date. Its status is computed from the date, so the tag changes with it;
+// ParseIfMatch ignores the date.
+func certificationETag(version int64, now time.Time) string {
+	return `"v` + strconv.FormatInt(version, 10) + "-" + now.UTC().Format("2006-01-02") + `"`
+}
+
+// statusExpires marks a response holding certification statuses as stale at
+// the next UTC midnight, when the statuses may change.
+func statusExpires(c *fiber.Ctx, now time.Time) {
+	midnight := time.Date(now.UTC().Year(), now.UTC().Month(), now.UTC().Day()+1, 0, 0, 0, 0, time.UTC)
+	c.Set(fiber.HeaderExpires, midnight.Format(http.TimeFormat))
+}
+
 func GetCertifications(c *fiber.Ctx) error {
+	expand, ok := parseExpand(c)
+	if !ok {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid expand, only projects is supported", nil, "")
+	}
+
+	fields, invalid := parseFields(c, models.CertificationOrAchievements{})
+	if len(invalid) > 0 {
+		return invalidFields(c, invalid)
+	}
+
+	status := c.Query("status")
+	if status != "" && !models.IsCertificationStatus(status) {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid status, expected one of active, expiring_soon, expired, no_expiry", nil, "")
+	}
+
+	also := expandedFields(expand)
+	if status != "" {
+		// The filter needs the status even when the response leaves it out
+		also = append(also, "status")
+	}
+	projection := fieldProjection(models.CertificationOrAchievements{}, fields, also...)
+
+	// Statuses depend on today's date as well as the stored documents
+	watched := []mgm.Model{&models.CertificationOrAchievements{}}
+	if expand {
+		watched = append(watched, &models.Project{})
+	}
+	now := time.Now()
+	statusExpires(c, now)
+	if listNotModified(c, "certifications", now.UTC().Format("2006-01-02"), watched...) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	// Since there's only one user and we want public access,
 	// fetch all certifications directly from the database
-	var certs []models.CertificationOrAchievements
-	if err := mgm.Coll(&models.CertificationOrAchievements{}).SimpleFind(&certs, bson.M{}); err != nil {
+	certs, err := database.Certifications(c.Context(), now, CertificationExpiryWindowDays, status, findFields(projection))
+	if err != nil {
 		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch certifications", nil, "")
 	}
 
@@ -21,19 +79,29 @@ func GetCertifications(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusOK, "No certifications found", nil, "")
 	}
 
-	certs = reverseCerts(certs)
+	if expand {
+		expanded, err := expandCertifications(c.Context(), certs)
+		if err != nil {
+			return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch certification projects", nil, "")
+		}
+		return sparse(c, "Certifications retrieved successfully", expanded, fields, expandedFields(expand)...)
+	}
 
-	return util.ResponseAPI(c, fiber.StatusOK, "Certifications retrieved successfully", certs, "")
+	return sparse(c, "Certifications retrieved successfully", models.CertificationResponses(certs), fields)
 }
 
-func reverseCerts(certs []models.CertificationOrAchievements) []models.CertificationOrAchievements {
-	for i, j := 0, len(certs)-1; i < j; i, j = i+1, j-1 {
-		certs[i], certs[j] = certs[j], certs[i]
+func GetCertificationByID(c *fiber.Ctx) error {
+	expand, ok := parseExpand(c)
+	if !ok {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid expand, only projects is supported", nil, "")
 	}
-	return certs
-}
 
-func GetCertificationByID(c *fiber.Ctx) error {
+	fields, invalid := parseFields(c, models.CertificationOrAchievements{})
+	if len(invalid) > 0 {
+		return invalidFields(c, invalid)
+	}
+	projection := fieldProjection(models.CertificationOrAchievements{}, fields, expandedFields(expand)...)
+
 	cid := c.Params("id")
 	if cid == "" {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Certification ID is required", nil, "")
@@ -44,40 +112,77 @@ func GetCertificationByID(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid certification ID", nil, "")
 	}
 
-	var cert models.CertificationOrAchievements
-	if err := mgm.Coll(&models.CertificationOrAchievements{}).FindByID(certObjID, &cert); err != nil {
+	now := time.Now()
+	cert, err := database.CertificationByID(c.Context(), certObjID, now, CertificationExpiryWindowDays, findOneFields(projection))
+	if errors.Is(err, database.ErrNotFound) {
 		return util.ResponseAPI(c, fiber.StatusNotFound, "Certification not found", nil, "")
+	} else if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch certification", nil, "")
 	}
+	c.Set(fiber.HeaderETag, certificationETag(cert.Version, now))
+	statusExpires(c, now)
 
-	return util.ResponseAPI(c, fiber.StatusOK, "Certification retrieved successfully", cert, "")
+	if expand {
+		expanded, err := expandCertifications(c.Context(), []models.CertificationOrAchievements{cert})
+		if err != nil {
+			return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch certification projects", nil, "")
+		}
+		return sparse(c, "Certification retrieved successfully", expanded[0], fields, expandedFields(expand)...)
+	}
+
+	return sparse(c, "Certification retrieved successfully", cert.Response(), fields)
 }
 
-func AddCertification(c *fiber.Ctx) error {
-	var cert models.CertificationOrAchievements
-	if err := c.BodyParser(&cert); err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid request body", nil, "")
+func GetExpiringCertifications(c *fiber.Ctx) error {
+	days := CertificationExpiryWindowDays
+	if raw := c.Query("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			return util.ResponseAPI(c, fiber.StatusBadRequest, "days must be a non-negative integer", nil, "")
+		}
+		days = parsed
 	}
 
-	if cert.Title == "" || cert.Description == "" || cert.Issuer == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Title, description, and issuer are required", nil, "")
+	certs, err := database.ExpiringCertifications(c.Context(), time.Now(), days, CertificationExpiryWindowDays)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch expiring certifications", nil, "")
 	}
 
-	if err := mgm.Coll(&models.CertificationOrAchievements{}).Create(&cert); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to add certification", nil, "")
+	if len(certs) == 0 {
+		return util.ResponseAPI(c, fiber.StatusOK, "No certifications expiring within "+strconv.Itoa(days)+" days", nil, "")
+	}
+
+	return util.ResponseAPI(c, fiber.StatusOK, "Expiring certifications retrieved successfully", models.CertificationResponses(certs), "")
+}
+
+func AddCertification(c *fiber.Ctx) error {
+	var req models.CertificationRequest
+	if ok, err := bind(c, &req); !ok {
+		return err
 	}
+	cert := req.Model()
 
-	// Since there's only one user, get the first user from the database
-	var user models.User
-	if err := mgm.Coll(&models.User{}).First(bson.M{}, &user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
+	missing, err := missingProjectIDs(c.Context(), cert.Projects)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to verify linked projects", nil, "")
+	}
+	if len(missing) > 0 {
+		return util.ErrorAPI(c, fiber.StatusBadRequest, util.CodeUnknownReference, "Unknown project IDs", missing)
 	}
 
-	user.Certifications = append(user.Certifications, cert.ID)
-	if err := mgm.Coll(&models.User{}).Update(&user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update user certifications", nil, "")
+	cert.Version = 1
+	if err := database.CreateOwned(c.Context(), &cert); err != nil {
+		if errors.Is(err, database.ErrNoUser) {
+			return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
+		}
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to add certification", nil, "")
 	}
+	now := time.Now()
+	cert.ComputeStatus(now, CertificationExpiryWindowDays)
 
-	return util.ResponseAPI(c, fiber.StatusOK, "Certification added successfully", cert, "")
+	events.Publish(events.Certification, events.Created, cert.ID.Hex(), cert.Response())
+	c.Set(fiber.HeaderETag, certificationETag(cert.Version, now))
+	return util.ResponseAPI(c, fiber.StatusOK, "Certification added successfully", cert.Response(), "")
 }
 
 func UpdateCertification(c *fiber.Ctx) error {
@@ -91,16 +196,20 @@ func UpdateCertification(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid certification ID", nil, "")
 	}
 
-	var input models.CertificationOrAchievements
-	if err := c.BodyParser(&input); err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid request body", nil, "")
+	var input models.CertificationRequest
+	if ok, err := bind(c, &input); !ok {
+		return err
 	}
 
-	if input.Title == "" || input.Description == "" || input.Issuer == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Title, description, and issuer are required", nil, "")
+	missing, err := missingProjectIDs(c.Context(), input.Projects)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to verify linked projects", nil, "")
+	}
+	if len(missing) > 0 {
+		return util.ErrorAPI(c, fiber.StatusBadRequest, util.CodeUnknownReference, "Unknown project IDs", missing)
 	}
 
-	update := bson.M{"$set": bson.M{
+	set := bson.M{
 		"title":           input.Title,
 		"description":     input.Description,
 		"projects":        input.Projects,
@@ -110,56 +219,28 @@ func UpdateCertification(c *fiber.Ctx) error {
 		"issuer":          input.Issuer,
 		"issue_date":      input.IssueDate,
 		"expiry_date":     input.ExpiryDate,
-	}}
+	}
 
-	if _, err := mgm.Coll(&models.CertificationOrAchievements{}).UpdateByID(c.Context(), certObjID, update); err != nil {
+	var updated models.CertificationOrAchievements
+	err = database.UpdateVersioned(c.Context(), &updated, certObjID, set, util.ParseIfMatch(c.Get(fiber.HeaderIfMatch)))
+
+	var versionErr *database.VersionMismatchError
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		return util.ResponseAPI(c, fiber.StatusNotFound, "Certification not found", nil, "")
+	case errors.As(err, &versionErr):
+		return versionConflict(c, "Certification", versionErr)
+	case err != nil:
 		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update certification", nil, "")
 	}
+	now := time.Now()
+	updated.ComputeStatus(now, CertificationExpiryWindowDays)
 
-	return util.ResponseAPI(c, fiber.StatusOK, "Certification updated successfully", input, "")
+	events.Publish(events.Certification, events.Updated, updated.ID.Hex(), updated.Response())
+	c.Set(fiber.HeaderETag, certificationETag(updated.Version, now))
+	return util.ResponseAPI(c, fiber.StatusOK, "Certification updated successfully", updated.Response(), "")
 }
 
 func RemoveCertification(c *fiber.Ctx) error {
-	// Fetch first (and only) user
-	var user models.User
-	userColl := mgm.Coll(&models.User{})
-	if err := userColl.First(bson.M{}, &user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
-	}
-
-	cid := c.Params("id")
-	if cid == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Certification ID is required", nil, "")
-	}
-
-	certObjID, err := primitive.ObjectIDFromHex(cid)
-	if err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid certification ID", nil, "")
-	}
-
-	// Filter certification ID
-	newCerts := make([]primitive.ObjectID, 0, len(user.Certifications))
-	for _, id := range user.Certifications {
-		if id != certObjID {
-			newCerts = append(newCerts, id)
-		}
-	}
-	user.Certifications = newCerts
-
-	// Save updated user
-	if err := userColl.Update(&user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update user certifications", nil, "")
-	}
-
-	// Delete the certification document
-	certColl := mgm.Coll(&models.CertificationOrAchievements{})
-	cert := &models.CertificationOrAchievements{}
-	cert.SetID(certObjID)
-
-	if err := certColl.Delete(cert); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to delete certification", nil, "")
-	}
-
-
-	return util.ResponseAPI(c, fiber.StatusOK, "Certification removed successfully", nil, "")
+	return removeEntity(c, &models.CertificationOrAchievements{}, "Certification")
 }
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// PublicCacheControl is sent with every public list response so browsers and
+// the CDN can cache them and revalidate with ETag/Last-Modified.
+var PublicCacheControl = "public, max-age=60, stale-while-revalidate=300"
+
+// collectionState summarises the documents behind a list response. Any
+// create, update or delete changes either the count or the newest updated_at.
+type collectionState struct {
+	Count        int64     `bson:"count"`
+	LastModified time.Time `bson:"last_modified"`
+}
+
+func stateOf(ctx context.Context, model mgm.Model, filter bson.M) (collectionState, error) {
+	var state collectionState
+	pipeline := bson.A{
+		bson.M{"$match": filter},
+		bson.M{"$group": bson.M{"_id": nil, "count": bson.M{"$sum": 1}, "last_modified": bson.M{"$max": "$updated_at"}}},
+	}
+	_, err := mgm.Coll(model).SimpleAggregateFirstWithCtx(ctx, &state, pipeline...)
+	return state, err
+}
+
+// notModified sets ETag, Last-Modified and Cache-Control for a list response
+// derived from states and reports whether the client's copy is still current.
+// The ETag also covers the query string, since filters change the payload,
+// and extra, for inputs such as the current date that are not stored.
+func notModified(c *fiber.Ctx, scope string, extra string, states ...collectionState) bool {
+	h := sha1.New()
+	fmt.Fprintf(h, "%s|%s|%s", scope, c.Request().URI().QueryString(), extra)
+
+	var lastModified time.Time
+	for _, s := range states {
+		fmt.Fprintf(h, "|%d:%d", s.Count, s.LastModified.UnixNano())
+		if s.LastModified.After(lastModified) {
+			lastModified = s.LastModified
+		}
+	}
+
+	etag := fmt.Sprintf(`W/"%x"`, h.Sum(nil)[:12])
+	c.Set(fiber.HeaderETag, etag)
+	if !lastModified.IsZero() {
+		c.Set(fiber.HeaderLastModified, lastModified.UTC().Format(http.TimeFormat))
+	}
+	c.Set(fiber.HeaderCacheControl, PublicCacheControl)
+
+	return util.NotModified(c, etag, lastModified)
+}
+
+// listNotModified runs notModified over the full collections of watched.
+// If the state cannot be read the request is served normally.
+func listNotModified(c *fiber.Ctx, scope string, extra string, watched ...mgm.Model) bool {
+	states := make([]collectionState, 0, len(watched))
+	for _, model := range watched {
+		state, err := stateOf(c.Context(), model, bson.M{})
+		if err != nil {
+			return false
+		}
+		states = append(states, state)
+	}
+	return notModified(c, scope, extra, states...)
+}
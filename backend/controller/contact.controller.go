@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"errors"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/notifier"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	linkPattern = regexp.MustCompile(`(?i)https?://|www\.|\[url`)
+	spamPattern = regexp.MustCompile(`(?i)\b(casino|viagra|cialis|crypto ?currency|bitcoin|forex|backlinks?|seo (services|agency|ranking)|` +
+		`guest post|loan offer|escort|porn|weight loss|make money)\b`)
+)
+
+// ContactNotifier, when set, is told about every new message that is not
+// spam.
+var ContactNotifier notifier.Notifier
+
+// maxContactLinks is how many links a genuine message plausibly contains.
+const maxContactLinks = 2
+
+// contactSpamReasons lists the spam heuristics req matches.
+func contactSpamReasons(req models.ContactRequest) []string {
+	var reasons []string
+	if req.Website != "" {
+		reasons = append(reasons, "honeypot")
+	}
+	if len(linkPattern.FindAllStringIndex(req.Message, -1)) > maxContactLinks {
+		reasons = append(reasons, "links")
+	}
+	if linkPattern.MatchString(req.Name) {
+		reasons = append(reasons, "link_in_name")
+	}
+	if spamPattern.MatchString(req.Name + " " + req.Message) {
+		reasons = append(reasons, "keywords")
+	}
+	return reasons
+}
+
+func AddContactMessage(c *fiber.Ctx) error {
+	var req models.ContactRequest
+	if ok, err := bind(c, &req); !ok {
+		return err
+	}
+
+	msg := models.ContactMessage{
+		Name:    strings.TrimSpace(req.Name),
+		Email:   strings.TrimSpace(req.Email),
+		Message: strings.TrimSpace(req.Message),
+	}
+	msg.SpamReasons = contactSpamReasons(req)
+	msg.Spam = len(msg.SpamReasons) > 0
+
+	// A resubmitted form is stored once
+	recent := bson.M{"email": msg.Email, "message": msg.Message, "created_at": bson.M{"$gte": time.Now().Add(-24 * time.Hour)}}
+	err := mgm.Coll(&msg).FirstWithCtx(c.Context(), recent, &models.ContactMessage{})
+	if err == nil {
+		return util.ResponseAPI(c, fiber.StatusOK, "Message sent successfully", nil, "")
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to send message", nil, "")
+	}
+
+	if err := mgm.Coll(&msg).CreateWithCtx(c.Context(), &msg); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to send message", nil, "")
+	}
+
+	if ContactNotifier != nil && !msg.Spam {
+		// The message is stored, so a failed notification is not the sender's problem
+		err := ContactNotifier.Notify(c.Context(), notifier.Notification{
+			Event:   "contact.received",
+			Subject: "New contact message from " + msg.Name,
+			Message: msg.Message,
+			Data:    msg.Response(),
+			SentAt:  msg.CreatedAt.UTC(),
+		})
+		if err != nil {
+			slog.Error("Failed to notify about contact message", "id", msg.ID.Hex(), "error", err)
+		}
+	}
+
+	// Spam gets the same answer, so senders cannot probe the heuristics
+	return util.ResponseAPI(c, fiber.StatusOK, "Message sent successfully", nil, "")
+}
+
+// contactFilters maps the `status` parameter of the inbox listing to a
+// filter. The inbox holds messages that are neither archived nor spam.
+var contactFilters = map[string]bson.M{
+	"inbox":    {"spam": false, "archived_at": bson.M{"$exists": false}},
+	"new":      {"spam": false, "archived_at": bson.M{"$exists": false}, "handled_at": bson.M{"$exists": false}},
+	"handled":  {"spam": false, "archived_at": bson.M{"$exists": false}, "handled_at": bson.M{"$exists": true}},
+	"archived": {"archived_at": bson.M{"$exists": true}},
+	"spam":     {"spam": true},
+	"all":      {},
+}
+
+func GetContactMessages(c *fiber.Ctx) error {
+	filter, ok := contactFilters[c.Query("status", "inbox")]
+	if !ok {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid status, expected inbox, new, handled, archived, spam or all", nil, "")
+	}
+
+	limit := c.QueryInt("limit", 50)
+	if limit < 1 || limit > 200 {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "limit must be between 1 and 200", nil, "")
+	}
+
+	var msgs []models.ContactMessage
+	opts := options.Find().SetSort(bson.M{"created_at": -1}).SetLimit(int64(limit))
+	if err := mgm.Coll(&models.ContactMessage{}).SimpleFindWithCtx(c.Context(), &msgs, filter, opts); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch messages", nil, "")
+	}
+
+	return util.ResponseAPI(c, fiber.StatusOK, "Messages retrieved successfully", models.ContactMessageResponses(msgs), "")
+}
+
+// findContactMessage loads the message named by the `id` route parameter.
+// When ok is false the error response has already been written.
+func findContactMessage(c *fiber.Ctx) (msg models.ContactMessage, ok bool, err error) {
+	id, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return msg, false, util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid message ID", nil, "")
+	}
+
+	if err := mgm.Coll(&msg).FindByIDWithCtx(c.Context(), id, &msg); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return msg, false, util.ResponseAPI(c, fiber.StatusNotFound, "Message not found", nil, "")
+		}
+		return msg, false, util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch message", nil, "")
+	}
+	return msg, true, nil
+}
+
+// GetContactMessage returns a message and marks it read the first time.
+func GetContactMessage(c *fiber.Ctx) error {
+	msg, ok, err := findContactMessage(c)
+	if !ok {
+		return err
+	}
+
+	if msg.ReadAt == nil {
+		now := time.Now()
+		update := bson.M{"$set": bson.M{"read_at": now, "updated_at": now}}
+		if _, err := mgm.Coll(&msg).UpdateByID(c.Context(), msg.ID, update); err != nil {
+			return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to mark message read", nil, "")
+		}
+		msg.ReadAt = &now
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, "Message retrieved successfully", msg.Response(), "")
+}
+
+// UpdateContactMessage marks a message handled, archived or spam, or undoes
+// that.
+func UpdateContactMessage(c *fiber.Ctx) error {
+	msg, ok, err := findContactMessage(c)
+	if !ok {
+		return err
+	}
+
+	var req models.ContactUpdateRequest
+	if ok, err := bind(c, &req); !ok {
+		return err
+	}
+
+	now := time.Now()
+	set, unset := bson.M{"updated_at": now}, bson.M{}
+	stamp := func(field string, on *bool, current **time.Time) {
+		switch {
+		case on == nil:
+		case *on && *current == nil:
+			set[field] = now
+			*current = &now
+		case !*on:
+			unset[field] = ""
+			*current = nil
+		}
+	}
+	stamp("handled_at", req.Handled, &msg.HandledAt)
+	stamp("archived_at", req.Archived, &msg.ArchivedAt)
+	if req.Spam != nil {
+		set["spam"] = *req.Spam
+		msg.Spam = *req.Spam
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	if _, err := mgm.Coll(&msg).UpdateByID(c.Context(), msg.ID, update); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update message", nil, "")
+	}
+
+	msg.UpdatedAt = now
+	return util.ResponseAPI(c, fiber.StatusOK, "Message updated successfully", msg.Response(), "")
+}
+
+func RemoveContactMessage(c *fiber.Ctx) error {
+	msg, ok, err := findContactMessage(c)
+	if !ok {
+		return err
+	}
+
+	if err := mgm.Coll(&msg).DeleteWithCtx(c.Context(), &msg); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to delete message", nil, "")
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, "Message deleted successfully", nil, "")
+}
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/MishraShardendu22/models"
+)
+
+func TestContactSpamReasons(t *testing.T) {
+	tests := []struct {
+		name string
+		req  models.ContactRequest
+		want []string
+	}{
+		{"genuine", models.ContactRequest{Name: "Ana", Message: "Loved your portfolio, are you open to work?"}, nil},
+		{"links within limit", models.ContactRequest{Name: "Ana", Message: "See https://a.dev and www.b.dev"}, nil},
+		{"honeypot", models.ContactRequest{Name: "Ana", Message: "Hello there, nice site", Website: "x"}, []string{"honeypot"}},
+		{"too many links", models.ContactRequest{Name: "Ana", Message: "http://a.io https://b.io [url=c]"}, []string{"links"}},
+		{"link in name", models.ContactRequest{Name: "www.deals.example", Message: "Hello there, nice site"}, []string{"link_in_name"}},
+		{"keyword", models.ContactRequest{Name: "Ana", Message: "We offer SEO services for your site"}, []string{"keywords"}},
+		{"keyword in name", models.ContactRequest{Name: "Casino Royale", Message: "Hello there, nice site"}, []string{"keywords"}},
+		// Keywords must be whole words
+		{"keyword inside a word", models.ContactRequest{Name: "Ana", Message: "I run a bitcoinless payments startup"}, nil},
+		{"several", models.ContactRequest{Name: "http://spam.example", Message: "Make money fast", Website: "x"}, []string{"honeypot", "link_in_name", "keywords"}},
+	}
+
+	for _, tt := range tests {
+		if got := contactSpamReasons(tt.req); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: contactSpamReasons = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/MishraShardendu22/cache"
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/events"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DeletePolicy is applied by every Remove* handler unless the request
+// overrides it with `?policy=cascade|restrict`.
+var DeletePolicy = database.DeleteCascade
+
+// entityCacheTags maps entity names to the read cache tags of their
+// responses.
+var entityCacheTags = map[string]string{
+	"project":       cache.TagProjects,
+	"experience":    cache.TagExperiences,
+	"certification": cache.TagCertifications,
+}
+
+// invalidateReferrers drops the cached responses of the entities a cascade
+// delete from model's collection may have rewritten.
+func invalidateReferrers(model mgm.Model) {
+	tags := []string{cache.TagRelations}
+	for _, entity := range database.ReferencingEntities(model) {
+		tags = append(tags, entityCacheTags[entity])
+	}
+	cache.Default.Invalidate(tags...)
+}
+
+// removeEntity deletes the document named by the `id` route parameter from
+// model's collection, applying the delete policy to anything referencing it.
+func removeEntity(c *fiber.Ctx, model mgm.Model, label string) error {
+	id := c.Params("id")
+	if id == "" {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, label+" ID is required", nil, "")
+	}
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid "+strings.ToLower(label)+" ID", nil, "")
+	}
+
+	policy := DeletePolicy
+	if raw := c.Query("policy"); raw != "" {
+		p, ok := database.ParseDeletePolicy(raw)
+		if !ok {
+			return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid policy, expected cascade or restrict", nil, "")
+		}
+		policy = p
+	}
+
+	rewritten, err := database.DeleteWithPolicy(c.Context(), model, objID, policy, util.ParseIfMatch(c.Get(fiber.HeaderIfMatch)))
+	if policy == database.DeleteCascade && !isDeleteRejection(err) {
+		// Without a transaction a failed delete may still have removed references
+		invalidateReferrers(model)
+	}
+
+	var refErr *database.ReferencedError
+	var versionErr *database.VersionMismatchError
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		return util.ResponseAPI(c, fiber.StatusNotFound, label+" not found", nil, "")
+	case errors.As(err, &versionErr):
+		return versionConflict(c, label, versionErr)
+	case errors.As(err, &refErr):
+		return util.ErrorAPI(c, fiber.StatusConflict, util.CodeReferenced, label+" is still referenced", refErr.Referrers)
+	case err != nil:
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to delete "+strings.ToLower(label), nil, "")
+	}
+
+	// Entity names in events are the lowercase labels, e.g. "project"
+	events.Publish(strings.ToLower(label), events.Deleted, id, nil)
+	publishRewritten(c.Context(), rewritten)
+	return util.ResponseAPI(c, fiber.StatusOK, label+" removed successfully", nil, "")
+}
+
+// isDeleteRejection reports whether err means the delete was refused before
+// anything was written.
+func isDeleteRejection(err error) bool {
+	var refErr *database.ReferencedError
+	var versionErr *database.VersionMismatchError
+	return errors.Is(err, database.ErrNotFound) || errors.As(err, &versionErr) || errors.As(err, &refErr)
+}
+
+// publishRewritten publishes an update for every document whose references
+// were rewritten, since subscribers cannot tell from the delete alone.
+// Documents that are gone by now are skipped.
+func publishRewritten(ctx context.Context, docs []database.Referrer) {
+	for _, doc := range docs {
+		id, err := primitive.ObjectIDFromHex(doc.ID)
+		if err != nil {
+			continue
+		}
+
+		var data any
+		switch doc.Entity {
+		case events.Experience:
+			exp, err := database.ExperienceByID(ctx, id)
+			if err != nil {
+				logRewriteLookup(doc, err)
+				continue
+			}
+			data = exp.Response()
+		case events.Certification:
+			cert, err := database.CertificationByID(ctx, id, time.Now(), CertificationExpiryWindowDays)
+			if err != nil {
+				logRewriteLookup(doc, err)
+				continue
+			}
+			data = cert.Response()
+		default:
+			continue
+		}
+		events.Publish(doc.Entity, events.Updated, doc.ID, data)
+	}
+}
+
+func logRewriteLookup(doc database.Referrer, err error) {
+	if !errors.Is(err, database.ErrNotFound) {
+		slog.Error("Failed to load rewritten document", "entity", doc.Entity, "id", doc.ID, "error", err)
+	}
+}
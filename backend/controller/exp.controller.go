@@ -1,6 +1,10 @@
 package controller
 
 import (
+	"errors"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/events"
 	"github.com/MishraShardendu22/models"
 	"github.com/MishraShardendu22/util"
 	"github.com/gofiber/fiber/v2"
@@ -10,10 +14,29 @@ import (
 )
 
 func GetExperiences(c *fiber.Ctx) error {
+	expand, ok := parseExpand(c)
+	if !ok {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid expand, only projects is supported", nil, "")
+	}
+
+	fields, invalid := parseFields(c, models.Experience{})
+	if len(invalid) > 0 {
+		return invalidFields(c, invalid)
+	}
+	projection := fieldProjection(models.Experience{}, fields, expandedFields(expand)...)
+
+	watched := []mgm.Model{&models.Experience{}}
+	if expand {
+		watched = append(watched, &models.Project{})
+	}
+	if listNotModified(c, "experiences", "", watched...) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	// Since there's only one user and we want public access,
 	// fetch all experiences directly from the database
-	var exps []models.Experience
-	if err := mgm.Coll(&models.Experience{}).SimpleFind(&exps, bson.M{}); err != nil {
+	exps, err := database.Experiences(c.Context(), findFields(projection))
+	if err != nil {
 		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch experiences", nil, "")
 	}
 
@@ -21,19 +44,29 @@ func GetExperiences(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusOK, "No experiences found", nil, "")
 	}
 
-	exps = reverseExperiences(exps)
+	if expand {
+		expanded, err := expandExperiences(c.Context(), exps)
+		if err != nil {
+			return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch experience projects", nil, "")
+		}
+		return sparse(c, "Experiences retrieved successfully", expanded, fields, expandedFields(expand)...)
+	}
 
-	return util.ResponseAPI(c, fiber.StatusOK, "Experiences retrieved successfully", exps, "")
+	return sparse(c, "Experiences retrieved successfully", models.ExperienceResponses(exps), fields)
 }
 
-func reverseExperiences(exps []models.Experience) []models.Experience {
-	for i, j := 0, len(exps)-1; i < j; i, j = i+1, j-1 {
-		exps[i], exps[j] = exps[j], exps[i]
+func GetExperienceByID(c *fiber.Ctx) error {
+	expand, ok := parseExpand(c)
+	if !ok {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid expand, only projects is supported", nil, "")
 	}
-	return exps
-}
 
-func GetExperienceByID(c *fiber.Ctx) error {
+	fields, invalid := parseFields(c, models.Experience{})
+	if len(invalid) > 0 {
+		return invalidFields(c, invalid)
+	}
+	projection := fieldProjection(models.Experience{}, fields, expandedFields(expand)...)
+
 	eid := c.Params("id")
 	if eid == "" {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Experience ID is required", nil, "")
@@ -44,40 +77,51 @@ func GetExperienceByID(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid experience ID", nil, "")
 	}
 
-	var e models.Experience
-	if err := mgm.Coll(&models.Experience{}).FindByID(expObjID, &e); err != nil {
+	e, err := database.ExperienceByID(c.Context(), expObjID, findOneFields(projection))
+	if errors.Is(err, database.ErrNotFound) {
 		return util.ResponseAPI(c, fiber.StatusNotFound, "Experience not found", nil, "")
+	} else if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch experience", nil, "")
 	}
+	c.Set(fiber.HeaderETag, util.VersionETag(e.Version))
 
-	return util.ResponseAPI(c, fiber.StatusOK, "Experience retrieved successfully", e, "")
+	if expand {
+		expanded, err := expandExperiences(c.Context(), []models.Experience{e})
+		if err != nil {
+			return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch experience projects", nil, "")
+		}
+		return sparse(c, "Experience retrieved successfully", expanded[0], fields, expandedFields(expand)...)
+	}
+
+	return sparse(c, "Experience retrieved successfully", e.Response(), fields)
 }
 
 func AddExperiences(c *fiber.Ctx) error {
-	var e models.Experience
-	if err := c.BodyParser(&e); err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid request body", nil, "")
-	}
-
-	if e.CompanyName == "" || e.Position == "" || e.StartDate == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Company name, position and start date are required", nil, "")
+	var req models.ExperienceRequest
+	if ok, err := bind(c, &req); !ok {
+		return err
 	}
+	e := req.Model()
 
-	if err := mgm.Coll(&models.Experience{}).Create(&e); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to add experience", nil, "")
+	missing, err := missingProjectIDs(c.Context(), e.Projects)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to verify linked projects", nil, "")
 	}
-
-	// Since there's only one user, get the first user from the database
-	var user models.User
-	if err := mgm.Coll(&models.User{}).First(bson.M{}, &user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
+	if len(missing) > 0 {
+		return util.ErrorAPI(c, fiber.StatusBadRequest, util.CodeUnknownReference, "Unknown project IDs", missing)
 	}
 
-	user.Experiences = append(user.Experiences, e.ID)
-	if err := mgm.Coll(&models.User{}).Update(&user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update user experiences", nil, "")
+	e.Version = 1
+	if err := database.CreateOwned(c.Context(), &e); err != nil {
+		if errors.Is(err, database.ErrNoUser) {
+			return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
+		}
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to add experience", nil, "")
 	}
 
-	return util.ResponseAPI(c, fiber.StatusOK, "Experience added successfully", e, "")
+	events.Publish(events.Experience, events.Created, e.ID.Hex(), e.Response())
+	c.Set(fiber.HeaderETag, util.VersionETag(e.Version))
+	return util.ResponseAPI(c, fiber.StatusOK, "Experience added successfully", e.Response(), "")
 }
 
 func UpdateExperiences(c *fiber.Ctx) error {
@@ -91,16 +135,20 @@ func UpdateExperiences(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid experience ID", nil, "")
 	}
 
-	var input models.Experience
-	if err := c.BodyParser(&input); err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid request body", nil, "")
+	var input models.ExperienceRequest
+	if ok, err := bind(c, &input); !ok {
+		return err
 	}
 
-	if input.CompanyName == "" || input.Position == "" || input.StartDate == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Company name, position and start date are required", nil, "")
+	missing, err := missingProjectIDs(c.Context(), input.Projects)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to verify linked projects", nil, "")
+	}
+	if len(missing) > 0 {
+		return util.ErrorAPI(c, fiber.StatusBadRequest, util.CodeUnknownReference, "Unknown project IDs", missing)
 	}
 
-	update := bson.M{"$set": bson.M{
+	set := bson.M{
 		"company_name":    input.CompanyName,
 		"position":        input.Position,
 		"start_date":      input.StartDate,
@@ -111,56 +159,26 @@ func UpdateExperiences(c *fiber.Ctx) error {
 		"certificate_url": input.CertificateURL,
 		"images":          input.Images,
 		"projects":        input.Projects,
-	}}
-
-	if _, err := mgm.Coll(&models.Experience{}).UpdateByID(c.Context(), expObjID, update); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update experience", nil, "")
-	}
-
-	return util.ResponseAPI(c, fiber.StatusOK, "Experience updated successfully", input, "")
-}
-
-func RemoveExperiences(c *fiber.Ctx) error {
-	// Since there's only one user, get the first user from the database
-	var user models.User
-	if err := mgm.Coll(&models.User{}).First(bson.M{}, &user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
-	}
-
-	eid := c.Params("id")
-	if eid == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Experience ID is required", nil, "")
 	}
 
-	var updated []primitive.ObjectID
-	found := false
-	for _, expID := range user.Experiences {
-		if expID.Hex() == eid {
-			found = true
-			continue
-		}
-		updated = append(updated, expID)
-	}
+	var updated models.Experience
+	err = database.UpdateVersioned(c.Context(), &updated, expObjID, set, util.ParseIfMatch(c.Get(fiber.HeaderIfMatch)))
 
-	if !found {
+	var versionErr *database.VersionMismatchError
+	switch {
+	case errors.Is(err, database.ErrNotFound):
 		return util.ResponseAPI(c, fiber.StatusNotFound, "Experience not found", nil, "")
+	case errors.As(err, &versionErr):
+		return versionConflict(c, "Experience", versionErr)
+	case err != nil:
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update experience", nil, "")
 	}
 
-	user.Experiences = updated
-	if err := mgm.Coll(&models.User{}).Update(&user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to remove experience", nil, "")
-	}
-
-	expObjID, err := primitive.ObjectIDFromHex(eid)
-	if err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid experience ID", nil, "")
-	}
-
-	proj := &models.Experience{}
-	proj.SetID(expObjID)
-	if err := mgm.Coll(proj).Delete(proj); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to delete experience", nil, "")
-	}
+	events.Publish(events.Experience, events.Updated, updated.ID.Hex(), updated.Response())
+	c.Set(fiber.HeaderETag, util.VersionETag(updated.Version))
+	return util.ResponseAPI(c, fiber.StatusOK, "Experience updated successfully", updated.Response(), "")
+}
 
-	return util.ResponseAPI(c, fiber.StatusOK, "Experience removed successfully", nil, "")
+func RemoveExperiences(c *fiber.Ctx) error {
+	return removeEntity(c, &models.Experience{}, "Experience")
 }
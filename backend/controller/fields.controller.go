@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"strings"
+
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// alwaysProjected are read even when not requested: the ID identifies the
+// document and version feeds the ETag.
+var alwaysProjected = []string{"_id", "version", "updated_at"}
+
+// computedFrom lists the stored fields a computed field is derived from.
+var computedFrom = map[string][]string{
+	"status": {"expiry_date"},
+}
+
+// responseKeep are JSON keys kept in every sparse response.
+var responseKeep = []string{"id"}
+
+// parseFields validates `?fields=` against model. It returns nil fields when
+// the parameter is absent and the unknown names when any are invalid.
+func parseFields(c *fiber.Ctx, model any) (fields []string, invalid []string) {
+	return util.ParseFields(c.Query("fields"), util.ModelFields(model))
+}
+
+func invalidFields(c *fiber.Ctx, invalid []string) error {
+	return util.ErrorAPI(c, fiber.StatusBadRequest, util.CodeUnknownFields, "Unknown fields: "+strings.Join(invalid, ", "), invalid)
+}
+
+// fieldProjection turns requested JSON fields into a Mongo projection. also
+// names extra JSON fields the handler needs, for example projects when
+// expanding. It returns nil when fields is nil so the whole document is read.
+func fieldProjection(model any, fields []string, also ...string) bson.M {
+	if fields == nil {
+		return nil
+	}
+
+	known := util.ModelFields(model)
+	projection := bson.M{}
+	for _, name := range alwaysProjected {
+		projection[name] = 1
+	}
+	for _, name := range append(append([]string{}, fields...), also...) {
+		if bsonName := known[name]; bsonName != "" {
+			projection[bsonName] = 1
+		}
+		for _, source := range computedFrom[name] {
+			projection[source] = 1
+		}
+	}
+	return projection
+}
+
+func findFields(projection bson.M) *options.FindOptions {
+	opts := options.Find()
+	if projection != nil {
+		opts.SetProjection(projection)
+	}
+	return opts
+}
+
+func findOneFields(projection bson.M) *options.FindOneOptions {
+	opts := options.FindOne()
+	if projection != nil {
+		opts.SetProjection(projection)
+	}
+	return opts
+}
+
+// sparse trims data to the requested fields plus also, or returns it whole
+// when no fields were requested.
+func sparse(c *fiber.Ctx, message string, data any, fields []string, also ...string) error {
+	trimmed, err := util.SelectFields(data, fields, append(also, responseKeep...)...)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to select fields", nil, "")
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, message, trimmed, "")
+}
+
+// expandedFields are the fields expansion reads on top of the requested ones.
+func expandedFields(expand bool) []string {
+	if expand {
+		return []string{"projects"}
+	}
+	return nil
+}
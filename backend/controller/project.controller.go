@@ -1,19 +1,31 @@
 package controller
 
 import (
+	"errors"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/events"
 	"github.com/MishraShardendu22/models"
 	"github.com/MishraShardendu22/util"
 	"github.com/gofiber/fiber/v2"
-	"github.com/kamva/mgm/v3"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func GetProjects(c *fiber.Ctx) error {
+	fields, invalid := parseFields(c, models.Project{})
+	if len(invalid) > 0 {
+		return invalidFields(c, invalid)
+	}
+
+	if listNotModified(c, "projects", "", &models.Project{}) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	// Since there's only one user and we want public access,
 	// fetch all projects directly from the database
-	var projects []models.Project
-	if err := mgm.Coll(&models.Project{}).SimpleFind(&projects, bson.M{}); err != nil {
+	projects, err := database.Projects(c.Context(), bson.M{}, findFields(fieldProjection(models.Project{}, fields)))
+	if err != nil {
 		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch projects", nil, "")
 	}
 
@@ -21,14 +33,15 @@ func GetProjects(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusOK, "No projects found", nil, "")
 	}
 
-	for i, j := 0, len(projects)-1; i < j; i, j = i+1, j-1 {
-		projects[i], projects[j] = projects[j], projects[i]
-	}
-
-	return util.ResponseAPI(c, fiber.StatusOK, "Projects retrieved successfully", projects, "")
+	return sparse(c, "Projects retrieved successfully", models.ProjectResponses(projects), fields)
 }
 
 func GetProjectByID(c *fiber.Ctx) error {
+	fields, invalid := parseFields(c, models.Project{})
+	if len(invalid) > 0 {
+		return invalidFields(c, invalid)
+	}
+
 	pid := c.Params("id")
 	if pid == "" {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Project ID is required", nil, "")
@@ -37,35 +50,34 @@ func GetProjectByID(c *fiber.Ctx) error {
 	if err != nil {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid project ID", nil, "")
 	}
-	var p models.Project
-	if err := mgm.Coll(&models.Project{}).FindByID(projObjID, &p); err != nil {
+	p, err := database.ProjectByID(c.Context(), projObjID, findOneFields(fieldProjection(models.Project{}, fields)))
+	if errors.Is(err, database.ErrNotFound) {
 		return util.ResponseAPI(c, fiber.StatusNotFound, "Project not found", nil, "")
+	} else if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch project", nil, "")
 	}
-	return util.ResponseAPI(c, fiber.StatusOK, "Project retrieved successfully", p, "")
+	c.Set(fiber.HeaderETag, util.VersionETag(p.Version))
+	return sparse(c, "Project retrieved successfully", p.Response(), fields)
 }
 
 func AddProjects(c *fiber.Ctx) error {
-	var p models.Project
-	if err := c.BodyParser(&p); err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid request body", nil, "")
+	var req models.ProjectRequest
+	if ok, err := bind(c, &req); !ok {
+		return err
 	}
-	if p.ProjectName == "" || p.SmallDescription == "" || p.Description == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Name, small description and description are required", nil, "")
-	}
-	if err := mgm.Coll(&models.Project{}).Create(&p); err != nil {
+
+	p := req.Model()
+	p.Version = 1
+	if err := database.CreateOwned(c.Context(), &p); err != nil {
+		if errors.Is(err, database.ErrNoUser) {
+			return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
+		}
 		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to add project", nil, "")
 	}
 
-	// Since there's only one user, get the first user from the database
-	var user models.User
-	if err := mgm.Coll(&models.User{}).First(bson.M{}, &user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
-	}
-	user.Projects = append(user.Projects, p.ID)
-	if err := mgm.Coll(&models.User{}).Update(&user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update user projects", nil, "")
-	}
-	return util.ResponseAPI(c, fiber.StatusOK, "Project added successfully", p, "")
+	events.Publish(events.Project, events.Created, p.ID.Hex(), p.Response())
+	c.Set(fiber.HeaderETag, util.VersionETag(p.Version))
+	return util.ResponseAPI(c, fiber.StatusOK, "Project added successfully", p.Response(), "")
 }
 
 func UpdateProjects(c *fiber.Ctx) error {
@@ -78,15 +90,12 @@ func UpdateProjects(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid project ID", nil, "")
 	}
 
-	var input models.Project
-	if err := c.BodyParser(&input); err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid request body", nil, "")
-	}
-	if input.ProjectName == "" || input.SmallDescription == "" || input.Description == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Name, small description and description are required", nil, "")
+	var input models.ProjectRequest
+	if ok, err := bind(c, &input); !ok {
+		return err
 	}
 
-	update := bson.M{"$set": bson.M{
+	set := bson.M{
 		"project_name":       input.ProjectName,
 		"small_description":  input.SmallDescription,
 		"description":        input.Description,
@@ -94,50 +103,26 @@ func UpdateProjects(c *fiber.Ctx) error {
 		"project_repository": input.ProjectRepository,
 		"project_live_link":  input.ProjectLiveLink,
 		"project_video":      input.ProjectVideo,
-	}}
-	if _, err := mgm.Coll(&models.Project{}).UpdateByID(c.Context(), projObjID, update); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update project", nil, "")
 	}
-	return util.ResponseAPI(c, fiber.StatusOK, "Project updated successfully", input, "")
-}
 
-func RemoveProjects(c *fiber.Ctx) error {
-	var user models.User
-	if err := mgm.Coll(&models.User{}).First(bson.M{}, &user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
-	}
-
-	pid := c.Params("id")
-	if pid == "" {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Project ID is required", nil, "")
-	}
-
-	// Convert pid to ObjectID
-	objID, err := primitive.ObjectIDFromHex(pid)
-	if err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid project ID", nil, "")
-	}
+	var updated models.Project
+	err = database.UpdateVersioned(c.Context(), &updated, projObjID, set, util.ParseIfMatch(c.Get(fiber.HeaderIfMatch)))
 
-	// Remove reference from user.Projects
-	updated := make([]primitive.ObjectID, 0, len(user.Projects))
-	for _, projID := range user.Projects {
-		if projID != objID {
-			updated = append(updated, projID)
-		}
-	}
-	user.Projects = updated
-
-	// Update user
-	if err := mgm.Coll(&models.User{}).Update(&user); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update user", nil, "")
+	var versionErr *database.VersionMismatchError
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		return util.ResponseAPI(c, fiber.StatusNotFound, "Project not found", nil, "")
+	case errors.As(err, &versionErr):
+		return versionConflict(c, "Project", versionErr)
+	case err != nil:
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update project", nil, "")
 	}
 
-	// Delete actual project
-	proj := &models.Project{}
-	proj.SetID(objID)
-	if err := mgm.Coll(proj).Delete(proj); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to delete project", nil, "")
-	}
+	events.Publish(events.Project, events.Updated, updated.ID.Hex(), updated.Response())
+	c.Set(fiber.HeaderETag, util.VersionETag(updated.Version))
+	return util.ResponseAPI(c, fiber.StatusOK, "Project updated successfully", updated.Response(), "")
+}
 
-	return util.ResponseAPI(c, fiber.StatusOK, "Project removed successfully", nil, "")
+func RemoveProjects(c *fiber.Ctx) error {
+	return removeEntity(c, &models.Project{}, "Project")
 }
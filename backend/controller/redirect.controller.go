@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/MishraShardendu22/analytics"
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RedirectLink records a click on an outbound link of a piece of content and
+// redirects to the URL stored in that field. Only fields listed in
+// models.OutboundLinks are followed, so the endpoint cannot be used as an
+// open redirect.
+func RedirectLink(c *fiber.Ctx) error {
+	entity, field := c.Params("entity"), c.Params("field")
+	fields, ok := models.OutboundLinks[entity]
+	if !ok {
+		return util.ResponseAPI(c, fiber.StatusNotFound, "Unknown content type", nil, "")
+	}
+	if !slices.Contains(fields, field) {
+		return util.ResponseAPI(c, fiber.StatusNotFound, "Unknown link", nil, "")
+	}
+
+	id, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid ID", nil, "")
+	}
+
+	target, err := linkURL(c.Context(), entity, id, field)
+	if errors.Is(err, database.ErrNotFound) {
+		return util.ResponseAPI(c, fiber.StatusNotFound, "Content not found", nil, "")
+	} else if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch link", nil, "")
+	}
+	if u, err := url.Parse(target); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return util.ResponseAPI(c, fiber.StatusNotFound, "Link not set", nil, "")
+	}
+
+	if c.Get("Sec-Purpose") == "" && c.Get("Purpose") != "prefetch" {
+		analytics.Default.Track(analytics.View{
+			Entity:    entity,
+			EntityID:  id,
+			Link:      utils.CopyString(field),
+			IP:        utils.CopyString(c.IP()),
+			UserAgent: utils.CopyString(c.Get(fiber.HeaderUserAgent)),
+			At:        time.Now(),
+		})
+	}
+
+	// Every click has to reach the server to be counted
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	c.Set("X-Robots-Tag", "noindex")
+	return c.Redirect(target, fiber.StatusFound)
+}
+
+// linkURL loads the URL stored in field of the given content.
+func linkURL(ctx context.Context, entity string, id primitive.ObjectID, field string) (string, error) {
+	switch entity {
+	case models.ViewProject:
+		p, err := database.ProjectByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		switch field {
+		case "project_live_link":
+			return p.ProjectLiveLink, nil
+		case "project_repository":
+			return p.ProjectRepository, nil
+		case "project_video":
+			return p.ProjectVideo, nil
+		}
+	case models.ViewExperience:
+		e, err := database.ExperienceByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return e.CertificateURL, nil
+	case models.ViewCertification:
+		cert, err := database.CertificationByID(ctx, id, time.Now(), CertificationExpiryWindowDays)
+		if err != nil {
+			return "", err
+		}
+		return cert.CertificateURL, nil
+	}
+	return "", nil
+}
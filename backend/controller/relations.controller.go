@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Responses embed the response DTO and shadow its `projects` ID list with
+// the referenced projects when `?expand=projects` is requested.
+type experienceWithProjects struct {
+	models.ExperienceResponse
+	Projects []models.ProjectResponse `json:"projects"`
+}
+
+type certificationWithProjects struct {
+	models.CertificationResponse
+	Projects []models.ProjectResponse `json:"projects"`
+}
+
+// parseExpand reads the comma separated `expand` query parameter and reports
+// whether projects should be embedded. ok is false for unsupported values.
+func parseExpand(c *fiber.Ctx) (projects bool, ok bool) {
+	raw := c.Query("expand")
+	if raw == "" {
+		return false, true
+	}
+
+	for _, part := range strings.Split(raw, ",") {
+		switch strings.TrimSpace(part) {
+		case "projects":
+			projects = true
+		case "":
+		default:
+			return false, false
+		}
+	}
+	return projects, true
+}
+
+func uniqueObjectIDs(ids []primitive.ObjectID) []primitive.ObjectID {
+	seen := make(map[primitive.ObjectID]struct{}, len(ids))
+	unique := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+// missingProjectIDs returns the hex IDs from ids that have no Project document.
+func missingProjectIDs(ctx context.Context, ids []primitive.ObjectID) ([]string, error) {
+	ids = uniqueObjectIDs(ids)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var found []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	if err := mgm.Coll(&models.Project{}).SimpleFindWithCtx(ctx, &found, bson.M{"_id": bson.M{"$in": ids}}, opts); err != nil {
+		return nil, err
+	}
+
+	existing := make(map[primitive.ObjectID]struct{}, len(found))
+	for _, f := range found {
+		existing[f.ID] = struct{}{}
+	}
+
+	var missing []string
+	for _, id := range ids {
+		if _, ok := existing[id]; !ok {
+			missing = append(missing, id.Hex())
+		}
+	}
+	return missing, nil
+}
+
+// loadProjects fetches every referenced project in a single query, keyed by ID.
+func loadProjects(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]models.Project, error) {
+	ids = uniqueObjectIDs(ids)
+	byID := make(map[primitive.ObjectID]models.Project, len(ids))
+	if len(ids) == 0 {
+		return byID, nil
+	}
+
+	var projects []models.Project
+	if err := mgm.Coll(&models.Project{}).SimpleFindWithCtx(ctx, &projects, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		return nil, err
+	}
+
+	for _, p := range projects {
+		byID[p.ID] = p
+	}
+	return byID, nil
+}
+
+// pickProjects keeps the order of ids and skips references that no longer resolve.
+func pickProjects(byID map[primitive.ObjectID]models.Project, ids []primitive.ObjectID) []models.ProjectResponse {
+	projects := make([]models.ProjectResponse, 0, len(ids))
+	for _, id := range ids {
+		if p, ok := byID[id]; ok {
+			projects = append(projects, p.Response())
+		}
+	}
+	return projects
+}
+
+func expandExperiences(ctx context.Context, exps []models.Experience) ([]experienceWithProjects, error) {
+	var ids []primitive.ObjectID
+	for _, e := range exps {
+		ids = append(ids, e.Projects...)
+	}
+
+	byID, err := loadProjects(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	expanded := make([]experienceWithProjects, 0, len(exps))
+	for _, e := range exps {
+		expanded = append(expanded, experienceWithProjects{ExperienceResponse: e.Response(), Projects: pickProjects(byID, e.Projects)})
+	}
+	return expanded, nil
+}
+
+func expandCertifications(ctx context.Context, certs []models.CertificationOrAchievements) ([]certificationWithProjects, error) {
+	var ids []primitive.ObjectID
+	for _, cert := range certs {
+		ids = append(ids, cert.Projects...)
+	}
+
+	byID, err := loadProjects(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	expanded := make([]certificationWithProjects, 0, len(certs))
+	for _, cert := range certs {
+		expanded = append(expanded, certificationWithProjects{CertificationResponse: cert.Response(), Projects: pickProjects(byID, cert.Projects)})
+	}
+	return expanded, nil
+}
+
+type similarProject struct {
+	models.Project `bson:",inline"`
+	SharedSkills   []string `bson:"shared_skills"`
+}
+
+type SimilarProjectResponse struct {
+	models.ProjectResponse
+	SharedSkills []string `json:"shared_skills"`
+}
+
+type ProjectRelations struct {
+	Experiences     []models.ExperienceResponse    `json:"experiences"`
+	Certifications  []models.CertificationResponse `json:"certifications"`
+	SimilarProjects []SimilarProjectResponse       `json:"similar_projects"`
+}
+
+func GetProjectRelations(c *fiber.Ctx) error {
+	pid := c.Params("id")
+	projObjID, err := primitive.ObjectIDFromHex(pid)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid project ID", nil, "")
+	}
+
+	limit := c.QueryInt("limit", 5)
+	if limit < 0 || limit > 50 {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "limit must be between 0 and 50", nil, "")
+	}
+
+	p, err := database.ProjectByID(c.Context(), projObjID)
+	if errors.Is(err, database.ErrNotFound) {
+		return util.ResponseAPI(c, fiber.StatusNotFound, "Project not found", nil, "")
+	} else if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch project", nil, "")
+	}
+
+	var exps []models.Experience
+	var certs []models.CertificationOrAchievements
+	var similar []similarProject
+
+	refFilter := bson.M{"projects": projObjID}
+	if err := mgm.Coll(&models.Experience{}).SimpleFindWithCtx(c.Context(), &exps, refFilter); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch related experiences", nil, "")
+	}
+	if err := mgm.Coll(&models.CertificationOrAchievements{}).SimpleFindWithCtx(c.Context(), &certs, refFilter); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch related certifications", nil, "")
+	}
+
+	now := time.Now()
+	for i := range certs {
+		certs[i].ComputeStatus(now, CertificationExpiryWindowDays)
+	}
+	statusExpires(c, now)
+
+	if len(p.Skills) > 0 && limit > 0 {
+		// Rank other projects by how many skills they share with this one
+		pipeline := bson.A{
+			bson.M{"$match": bson.M{"_id": bson.M{"$ne": projObjID}, "skills": bson.M{"$in": p.Skills}}},
+			bson.M{"$addFields": bson.M{"shared_skills": bson.M{"$setIntersection": bson.A{"$skills", p.Skills}}}},
+			bson.M{"$addFields": bson.M{"shared_count": bson.M{"$size": "$shared_skills"}}},
+			bson.M{"$sort": bson.D{{Key: "shared_count", Value: -1}, {Key: "created_at", Value: -1}}},
+			bson.M{"$limit": limit},
+		}
+		if err := mgm.Coll(&models.Project{}).SimpleAggregateWithCtx(c.Context(), &similar, pipeline...); err != nil {
+			return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch similar projects", nil, "")
+		}
+	}
+
+	related := ProjectRelations{
+		Experiences:     models.ExperienceResponses(exps),
+		Certifications:  models.CertificationResponses(certs),
+		SimilarProjects: make([]SimilarProjectResponse, 0, len(similar)),
+	}
+	for _, sp := range similar {
+		related.SimilarProjects = append(related.SimilarProjects, SimilarProjectResponse{ProjectResponse: sp.Project.Response(), SharedSkills: sp.SharedSkills})
+	}
+
+	return util.ResponseAPI(c, fiber.StatusOK, "Related content retrieved successfully", related, "")
+}
@@ -1,6 +1,10 @@
 package controller
 
 import (
+	"errors"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/events"
 	"github.com/MishraShardendu22/models"
 	"github.com/MishraShardendu22/util"
 	"github.com/gofiber/fiber/v2"
@@ -9,22 +13,14 @@ import (
 )
 
 func AddSkills(c *fiber.Ctx) error {
-	var payload struct {
-		Skills []string `json:"skills"`
-	}
-	err := c.BodyParser(&payload)
-
-	if err != nil {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid request body", nil, "")
-	}
-
-	if len(payload.Skills) == 0 {
-		return util.ResponseAPI(c, fiber.StatusBadRequest, "Skills cannot be empty", nil, "")
+	var payload models.SkillsRequest
+	if ok, err := bind(c, &payload); !ok {
+		return err
 	}
 
 	// Since there's only one user, get the first user from the database
 	user := &models.User{}
-	err = mgm.Coll(user).First(bson.M{}, user)
+	err := mgm.Coll(user).First(bson.M{}, user)
 	if err != nil {
 		return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
 	}
@@ -35,45 +31,31 @@ func AddSkills(c *fiber.Ctx) error {
 		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update skills", nil, "")
 	}
 
+	events.Publish(events.Skills, events.Updated, "", user.Skills)
 	return util.ResponseAPI(c, fiber.StatusOK, "Skills added successfully", user.Skills, "")
 }
 
 func GetSkills(c *fiber.Ctx) error {
-	user := &models.User{}
-	if err := mgm.Coll(user).First(bson.M{}, user); err != nil {
+	filter, err := database.SkillsFilter(c.Context())
+	if errors.Is(err, database.ErrNoUser) {
 		return util.ResponseAPI(c, fiber.StatusNotFound, "User not found", nil, "")
+	} else if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch user", nil, "")
 	}
 
-	if len(user.Projects) == 0 {
-		return util.ResponseAPI(c, fiber.StatusOK, "No projects found", nil, "")
+	if state, err := stateOf(c.Context(), &models.Project{}, filter); err == nil && notModified(c, "skills", "", state) {
+		return c.SendStatus(fiber.StatusNotModified)
 	}
 
-	var projects []models.Project
-	filter := bson.M{"_id": bson.M{"$in": user.Projects}}
-	cursor, err := mgm.Coll(&models.Project{}).Find(mgm.Ctx(), filter)
+	skills, err := database.Skills(c.Context(), filter)
 	if err != nil {
 		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch projects", nil, "")
 	}
-	if err := cursor.All(mgm.Ctx(), &projects); err != nil {
-		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to decode projects", nil, "")
-	}
-
-	skillSet := make(map[string]struct{}, 0)
-	for _, p := range projects {
-		for _, s := range p.Skills {
-			skillSet[s] = struct{}{}
-		}
-	}
 
-	if len(skillSet) == 0 {
+	if len(skills) == 0 {
 		return util.ResponseAPI(c, fiber.StatusOK, "No skills found", nil, "")
 	}
 
-	skills := make([]string, 0, len(skillSet))
-	for s := range skillSet {
-		skills = append(skills, s)
-	}
-
 	return util.ResponseAPI(c, fiber.StatusOK, "Skills retrieved successfully", skills, "")
 }
 
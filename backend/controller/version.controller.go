@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+)
+
+// versionConflict answers a write whose If-Match no longer matches with 412
+// and the current version, so the client can refetch and retry.
+func versionConflict(c *fiber.Ctx, label string, err *database.VersionMismatchError) error {
+	c.Set(fiber.HeaderETag, util.VersionETag(err.Current))
+	return util.ErrorAPI(c, fiber.StatusPreconditionFailed, util.CodeVersionConflict, label+" was modified since it was fetched", fiber.Map{
+		"current_version": err.Current,
+	})
+}
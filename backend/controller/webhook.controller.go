@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"errors"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/util"
+	"github.com/MishraShardendu22/webhooks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// findWebhook loads the subscription named by the `id` route parameter. When
+// ok is false the error response has already been written.
+func findWebhook(c *fiber.Ctx) (sub models.WebhookSubscription, ok bool, err error) {
+	id, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return sub, false, util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid webhook ID", nil, "")
+	}
+
+	if err := mgm.Coll(&sub).FindByIDWithCtx(c.Context(), id, &sub); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return sub, false, util.ResponseAPI(c, fiber.StatusNotFound, "Webhook not found", nil, "")
+		}
+		return sub, false, util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch webhook", nil, "")
+	}
+	return sub, true, nil
+}
+
+func GetWebhooks(c *fiber.Ctx) error {
+	var subs []models.WebhookSubscription
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+	if err := mgm.Coll(&models.WebhookSubscription{}).SimpleFindWithCtx(c.Context(), &subs, bson.M{}, opts); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch webhooks", nil, "")
+	}
+
+	return util.ResponseAPI(c, fiber.StatusOK, "Webhooks retrieved successfully", models.WebhookSubscriptionResponses(subs), "")
+}
+
+func GetWebhook(c *fiber.Ctx) error {
+	sub, ok, err := findWebhook(c)
+	if !ok {
+		return err
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, "Webhook retrieved successfully", sub.Response(), "")
+}
+
+func AddWebhook(c *fiber.Ctx) error {
+	var req models.WebhookRequest
+	if ok, err := bind(c, &req); !ok {
+		return err
+	}
+
+	sub := models.WebhookSubscription{
+		URL:         req.URL,
+		Secret:      req.Secret,
+		Events:      req.Events,
+		Description: req.Description,
+		Active:      req.Active == nil || *req.Active,
+	}
+	if sub.Secret == "" {
+		secret, err := webhooks.NewSecret()
+		if err != nil {
+			return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to generate webhook secret", nil, "")
+		}
+		sub.Secret = secret
+	}
+
+	if err := mgm.Coll(&sub).CreateWithCtx(c.Context(), &sub); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to add webhook", nil, "")
+	}
+
+	// The secret is only ever shown here
+	resp := sub.Response()
+	resp.Secret = sub.Secret
+	return util.ResponseAPI(c, fiber.StatusOK, "Webhook added successfully", resp, "")
+}
+
+func UpdateWebhook(c *fiber.Ctx) error {
+	sub, ok, err := findWebhook(c)
+	if !ok {
+		return err
+	}
+
+	var req models.WebhookRequest
+	if ok, err := bind(c, &req); !ok {
+		return err
+	}
+
+	sub.URL = req.URL
+	sub.Events = req.Events
+	sub.Description = req.Description
+	if req.Active != nil {
+		sub.Active = *req.Active
+	}
+	// Omitting the secret keeps the current one
+	if req.Secret != "" {
+		sub.Secret = req.Secret
+	}
+
+	if err := mgm.Coll(&sub).UpdateWithCtx(c.Context(), &sub); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to update webhook", nil, "")
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, "Webhook updated successfully", sub.Response(), "")
+}
+
+func RemoveWebhook(c *fiber.Ctx) error {
+	sub, ok, err := findWebhook(c)
+	if !ok {
+		return err
+	}
+
+	if err := mgm.Coll(&sub).DeleteWithCtx(c.Context(), &sub); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to delete webhook", nil, "")
+	}
+
+	// Pending retries would only fail later; the log is kept until it expires
+	filter := bson.M{"subscription_id": sub.ID, "status": models.DeliveryPending}
+	update := bson.M{"$set": bson.M{"status": models.DeliveryFailed, "last_error": "subscription was deleted", "updated_at": time.Now()}}
+	if _, err := mgm.Coll(&models.WebhookDelivery{}).UpdateMany(c.Context(), filter, update); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to cancel pending deliveries", nil, "")
+	}
+
+	return util.ResponseAPI(c, fiber.StatusOK, "Webhook removed successfully", nil, "")
+}
+
+func PingWebhook(c *fiber.Ctx) error {
+	sub, ok, err := findWebhook(c)
+	if !ok {
+		return err
+	}
+
+	delivery, err := webhooks.Default.Ping(c.Context(), sub)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to queue ping", nil, "")
+	}
+	return util.ResponseAPI(c, fiber.StatusAccepted, "Ping queued", delivery.Response(), "")
+}
+
+func GetWebhookDeliveries(c *fiber.Ctx) error {
+	sub, ok, err := findWebhook(c)
+	if !ok {
+		return err
+	}
+
+	limit := c.QueryInt("limit", 50)
+	if limit < 1 || limit > 200 {
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "limit must be between 1 and 200", nil, "")
+	}
+
+	filter := bson.M{"subscription_id": sub.ID}
+	switch status := c.Query("status"); status {
+	case "":
+	case models.DeliveryPending, models.DeliverySucceeded, models.DeliveryFailed:
+		filter["status"] = status
+	default:
+		return util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid status, expected pending, succeeded or failed", nil, "")
+	}
+
+	var deliveries []models.WebhookDelivery
+	opts := options.Find().SetSort(bson.M{"created_at": -1}).SetLimit(int64(limit))
+	if err := mgm.Coll(&models.WebhookDelivery{}).SimpleFindWithCtx(c.Context(), &deliveries, filter, opts); err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch deliveries", nil, "")
+	}
+
+	return util.ResponseAPI(c, fiber.StatusOK, "Deliveries retrieved successfully", models.WebhookDeliveryResponses(deliveries), "")
+}
+
+// findDelivery loads the delivery named by the `deliveryId` route parameter,
+// which must belong to the subscription named by `id`.
+func findDelivery(c *fiber.Ctx) (delivery models.WebhookDelivery, ok bool, err error) {
+	sub, ok, err := findWebhook(c)
+	if !ok {
+		return delivery, false, err
+	}
+
+	id, err := primitive.ObjectIDFromHex(c.Params("deliveryId"))
+	if err != nil {
+		return delivery, false, util.ResponseAPI(c, fiber.StatusBadRequest, "Invalid delivery ID", nil, "")
+	}
+
+	filter := bson.M{"_id": id, "subscription_id": sub.ID}
+	if err := mgm.Coll(&delivery).FirstWithCtx(c.Context(), filter, &delivery); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return delivery, false, util.ResponseAPI(c, fiber.StatusNotFound, "Delivery not found", nil, "")
+		}
+		return delivery, false, util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to fetch delivery", nil, "")
+	}
+	return delivery, true, nil
+}
+
+func GetWebhookDelivery(c *fiber.Ctx) error {
+	delivery, ok, err := findDelivery(c)
+	if !ok {
+		return err
+	}
+	return util.ResponseAPI(c, fiber.StatusOK, "Delivery retrieved successfully", delivery.Response(), "")
+}
+
+func RedeliverWebhook(c *fiber.Ctx) error {
+	original, ok, err := findDelivery(c)
+	if !ok {
+		return err
+	}
+
+	delivery, err := webhooks.Default.Redeliver(c.Context(), original)
+	if err != nil {
+		return util.ResponseAPI(c, fiber.StatusInternalServerError, "Failed to queue redelivery", nil, "")
+	}
+	return util.ResponseAPI(c, fiber.StatusAccepted, "Redelivery queued", delivery.Response(), "")
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ExpiringCertifications returns certifications whose expiry date falls
+// within the next `within` days, soonest first. Expiry dates are stored as
+// strings, so the filtering happens here rather than in the query.
+func ExpiringCertifications(ctx context.Context, now time.Time, within int, soonDays int) ([]models.CertificationOrAchievements, error) {
+	var certs []models.CertificationOrAchievements
+	filter := bson.M{"expiry_date": bson.M{"$nin": bson.A{"", nil}}}
+	if err := mgm.Coll(&models.CertificationOrAchievements{}).SimpleFindWithCtx(ctx, &certs, filter); err != nil {
+		return nil, err
+	}
+
+	expiring := make([]models.CertificationOrAchievements, 0)
+	for _, cert := range certs {
+		days, ok := cert.ExpiresIn(now)
+		if !ok || days < 0 || days > within {
+			continue
+		}
+		cert.ComputeStatus(now, soonDays)
+		expiring = append(expiring, cert)
+	}
+
+	sort.SliceStable(expiring, func(i, j int) bool {
+		di, _ := expiring[i].ExpiresIn(now)
+		dj, _ := expiring[j].ExpiresIn(now)
+		return di < dj
+	})
+
+	return expiring, nil
+}
+
+// UnalertedCertifications drops the certifications admins were already
+// alerted about for their current expiry date.
+func UnalertedCertifications(ctx context.Context, certs []models.CertificationOrAchievements) ([]models.CertificationOrAchievements, error) {
+	if len(certs) == 0 {
+		return certs, nil
+	}
+
+	ids := make([]primitive.ObjectID, len(certs))
+	for i, cert := range certs {
+		ids[i] = cert.ID
+	}
+	var alerts []models.CertificationExpiryAlert
+	filter := bson.M{"certification_id": bson.M{"$in": ids}}
+	if err := mgm.Coll(&models.CertificationExpiryAlert{}).SimpleFindWithCtx(ctx, &alerts, filter); err != nil {
+		return nil, err
+	}
+
+	alerted := make(map[primitive.ObjectID]map[string]bool, len(alerts))
+	for _, alert := range alerts {
+		if alerted[alert.CertificationID] == nil {
+			alerted[alert.CertificationID] = map[string]bool{}
+		}
+		alerted[alert.CertificationID][alert.ExpiryDate] = true
+	}
+
+	pending := make([]models.CertificationOrAchievements, 0, len(certs))
+	for _, cert := range certs {
+		if !alerted[cert.ID][cert.ExpiryDate] {
+			pending = append(pending, cert)
+		}
+	}
+	return pending, nil
+}
+
+// MarkCertificationsAlerted records that admins were alerted about certs at
+// now.
+func MarkCertificationsAlerted(ctx context.Context, certs []models.CertificationOrAchievements, now time.Time) error {
+	coll := mgm.Coll(&models.CertificationExpiryAlert{})
+	for _, cert := range certs {
+		filter := bson.M{"certification_id": cert.ID, "expiry_date": cert.ExpiryDate}
+		update := bson.M{
+			"$set":         bson.M{"notified_at": now, "updated_at": now},
+			"$setOnInsert": bson.M{"created_at": now},
+		}
+		if _, err := coll.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Read access to the portfolio content, shared by the REST, GraphQL and
+// gRPC APIs. Lists are returned most recently added first.
+
+func Projects(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]models.Project, error) {
+	var projects []models.Project
+	if err := mgm.Coll(&models.Project{}).SimpleFindWithCtx(ctx, &projects, filter, opts...); err != nil {
+		return nil, err
+	}
+	reverse(projects)
+	return projects, nil
+}
+
+// ProjectByID returns ErrNotFound when there is no such project.
+func ProjectByID(ctx context.Context, id primitive.ObjectID, opts ...*options.FindOneOptions) (models.Project, error) {
+	var p models.Project
+	err := findByID(ctx, &p, id, opts)
+	return p, err
+}
+
+func Experiences(ctx context.Context, opts ...*options.FindOptions) ([]models.Experience, error) {
+	var exps []models.Experience
+	if err := mgm.Coll(&models.Experience{}).SimpleFindWithCtx(ctx, &exps, bson.M{}, opts...); err != nil {
+		return nil, err
+	}
+	reverse(exps)
+	return exps, nil
+}
+
+// ExperienceByID returns ErrNotFound when there is no such experience.
+func ExperienceByID(ctx context.Context, id primitive.ObjectID, opts ...*options.FindOneOptions) (models.Experience, error) {
+	var e models.Experience
+	err := findByID(ctx, &e, id, opts)
+	return e, err
+}
+
+// Certifications returns certifications with their status computed for now,
+// keeping only those with the given status unless it is empty.
+func Certifications(ctx context.Context, now time.Time, soonDays int, status string, opts ...*options.FindOptions) ([]models.CertificationOrAchievements, error) {
+	var certs []models.CertificationOrAchievements
+	if err := mgm.Coll(&models.CertificationOrAchievements{}).SimpleFindWithCtx(ctx, &certs, bson.M{}, opts...); err != nil {
+		return nil, err
+	}
+
+	filtered := certs[:0]
+	for _, cert := range certs {
+		cert.ComputeStatus(now, soonDays)
+		if status == "" || cert.Status == status {
+			filtered = append(filtered, cert)
+		}
+	}
+	reverse(filtered)
+	return filtered, nil
+}
+
+// CertificationByID returns the certification with its status computed for
+// now, or ErrNotFound when there is no such certification.
+func CertificationByID(ctx context.Context, id primitive.ObjectID, now time.Time, soonDays int, opts ...*options.FindOneOptions) (models.CertificationOrAchievements, error) {
+	var cert models.CertificationOrAchievements
+	if err := findByID(ctx, &cert, id, opts); err != nil {
+		return cert, err
+	}
+	cert.ComputeStatus(now, soonDays)
+	return cert, nil
+}
+
+// SkillsFilter matches the projects whose skills make up the profile's skill
+// list. It returns ErrNoUser before the profile exists.
+func SkillsFilter(ctx context.Context) (bson.M, error) {
+	user := &models.User{}
+	if err := mgm.Coll(user).FirstWithCtx(ctx, bson.M{}, user); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrNoUser
+		}
+		return nil, err
+	}
+	return bson.M{"_id": bson.M{"$in": user.Projects}}, nil
+}
+
+// Skills returns the distinct skills of the projects matching filter, sorted.
+func Skills(ctx context.Context, filter bson.M) ([]string, error) {
+	values, err := mgm.Coll(&models.Project{}).Distinct(ctx, "skills", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	skills := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			skills = append(skills, s)
+		}
+	}
+	sort.Strings(skills)
+	return skills, nil
+}
+
+func findByID(ctx context.Context, model mgm.Model, id primitive.ObjectID, opts []*options.FindOneOptions) error {
+	err := mgm.Coll(model).FindOne(ctx, bson.M{"_id": id}, opts...).Decode(model)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// reverse puts documents read in insertion order newest first.
+func reverse[T any](docs []T) {
+	for i, j := 0, len(docs)-1; i < j; i, j = i+1, j-1 {
+		docs[i], docs[j] = docs[j], docs[i]
+	}
+}
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// IndexedModel is implemented by models that declare their own indexes.
+type IndexedModel interface {
+	mgm.Model
+	Indexes() []mongo.IndexModel
+}
+
+var indexedModels = []IndexedModel{
+	&models.User{},
+	&models.Project{},
+	&models.Experience{},
+	&models.CertificationOrAchievements{},
+	&models.CertificationExpiryAlert{},
+	&models.WebhookSubscription{},
+	&models.WebhookDelivery{},
+	&models.ViewSalt{},
+	&models.ViewVisitor{},
+	&models.ContentViewDay{},
+	&models.ReferrerViewDay{},
+	&models.LinkClickVisitor{},
+	&models.LinkClickDay{},
+	&models.ContactMessage{},
+	&models.MailMessage{},
+}
+
+// EnsureIndexes creates every declared index. Creating an index that already
+// exists with the same name and keys is a no-op, so this runs on every start.
+// A failing index (for example a unique index over duplicate data) is logged
+// and skipped; the joined errors are returned once all models are processed.
+func EnsureIndexes(ctx context.Context, logger *slog.Logger) error {
+	var errs []error
+	for _, model := range indexedModels {
+		coll := mgm.Coll(model)
+		for _, index := range model.Indexes() {
+			if _, err := coll.Indexes().CreateOne(ctx, index); err != nil {
+				logger.Error("Failed to ensure index", "collection", coll.Name(), "index", *index.Options.Name, "error", err)
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type IndexUsage struct {
+	Name     string     `json:"name"`
+	Keys     bson.M     `json:"keys"`
+	Declared bool       `json:"declared"`
+	Exists   bool       `json:"exists"`
+	Ops      int64      `json:"ops"`
+	Since    *time.Time `json:"since,omitempty"`
+}
+
+type CollectionIndexes struct {
+	Collection string       `json:"collection"`
+	Indexes    []IndexUsage `json:"indexes"`
+}
+
+// IndexStats reports, per collection, the existing indexes with their usage
+// counters from $indexStats, plus declared indexes that are missing.
+func IndexStats(ctx context.Context) ([]CollectionIndexes, error) {
+	report := make([]CollectionIndexes, 0, len(indexedModels))
+	for _, model := range indexedModels {
+		coll := mgm.Coll(model)
+
+		var stats []struct {
+			Name     string `bson:"name"`
+			Key      bson.M `bson:"key"`
+			Accesses struct {
+				Ops   int64     `bson:"ops"`
+				Since time.Time `bson:"since"`
+			} `bson:"accesses"`
+		}
+		if err := coll.SimpleAggregateWithCtx(ctx, &stats, bson.M{"$indexStats": bson.M{}}); err != nil {
+			return nil, err
+		}
+
+		declared := make(map[string]bool)
+		for _, index := range model.Indexes() {
+			declared[*index.Options.Name] = true
+		}
+
+		entry := CollectionIndexes{Collection: coll.Name(), Indexes: make([]IndexUsage, 0, len(stats))}
+		for _, s := range stats {
+			since := s.Accesses.Since
+			entry.Indexes = append(entry.Indexes, IndexUsage{
+				Name:     s.Name,
+				Keys:     s.Key,
+				Declared: declared[s.Name],
+				Exists:   true,
+				Ops:      s.Accesses.Ops,
+				Since:    &since,
+			})
+			delete(declared, s.Name)
+		}
+
+		for _, index := range model.Indexes() {
+			if declared[*index.Options.Name] {
+				keys := bson.M{}
+				for _, k := range index.Keys.(bson.D) {
+					keys[k.Key] = k.Value
+				}
+				entry.Indexes = append(entry.Indexes, IndexUsage{Name: *index.Options.Name, Keys: keys, Declared: true})
+			}
+		}
+
+		report = append(report, entry)
+	}
+	return report, nil
+}
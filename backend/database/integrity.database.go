@@ -0,0 +1,260 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// IssueOrphan is a document no user references.
+	IssueOrphan = "orphan"
+	// IssueDangling is a reference to a document that does not exist.
+	IssueDangling = "dangling"
+	// IssueDuplicate is an ID listed more than once in the same array.
+	IssueDuplicate = "duplicate"
+)
+
+type IntegrityIssue struct {
+	Kind     string `json:"kind"`
+	Entity   string `json:"entity"`
+	ID       string `json:"id"`
+	Owner    string `json:"owner"`
+	OwnerID  string `json:"owner_id,omitempty"`
+	Field    string `json:"field"`
+	Repaired bool   `json:"repaired"`
+}
+
+type IntegrityReport struct {
+	CheckedAt time.Time        `json:"checked_at"`
+	Repair    bool             `json:"repair"`
+	DryRun    bool             `json:"dry_run"`
+	Summary   map[string]int   `json:"summary"`
+	Issues    []IntegrityIssue `json:"issues"`
+}
+
+var ownedEntities = []struct {
+	entity string
+	model  mgm.Model
+}{
+	{entity: "project", model: &models.Project{}},
+	{entity: "experience", model: &models.Experience{}},
+	{entity: "certification", model: &models.CertificationOrAchievements{}},
+}
+
+type refDoc struct {
+	ID   primitive.ObjectID   `bson:"_id"`
+	Refs []primitive.ObjectID `bson:"refs"`
+}
+
+// CheckIntegrity scans the user reference arrays and the project references
+// on experiences and certifications without changing anything.
+func CheckIntegrity(ctx context.Context) (*IntegrityReport, error) {
+	return runIntegrity(ctx, false, true)
+}
+
+// RepairIntegrity fixes every issue CheckIntegrity would report: dangling and
+// duplicate IDs are removed and orphans are attached to the first user. With
+// dryRun the report lists what would change but nothing is written.
+func RepairIntegrity(ctx context.Context, dryRun bool) (*IntegrityReport, error) {
+	return runIntegrity(ctx, true, dryRun)
+}
+
+func runIntegrity(ctx context.Context, repair bool, dryRun bool) (*IntegrityReport, error) {
+	report := &IntegrityReport{
+		CheckedAt: time.Now().UTC(),
+		Repair:    repair,
+		DryRun:    repair && dryRun,
+		Summary:   map[string]int{IssueOrphan: 0, IssueDangling: 0, IssueDuplicate: 0},
+		Issues:    make([]IntegrityIssue, 0),
+	}
+	write := repair && !dryRun
+
+	existing := make(map[string]map[primitive.ObjectID]struct{}, len(ownedEntities))
+	for _, owned := range ownedEntities {
+		ids, err := allIDs(ctx, owned.model)
+		if err != nil {
+			return nil, err
+		}
+		existing[owned.entity] = ids
+	}
+
+	var users []models.User
+	if err := mgm.Coll(&models.User{}).SimpleFindWithCtx(ctx, &users, bson.M{}, options.Find().SetSort(bson.M{"_id": 1})); err != nil {
+		return nil, err
+	}
+
+	for _, owned := range ownedEntities {
+		field := ownerFields[mgm.CollName(owned.model)]
+		referenced := make(map[primitive.ObjectID]struct{})
+		cleaned := make([][]primitive.ObjectID, len(users))
+		changed := make([]bool, len(users))
+
+		for i, user := range users {
+			kept, issues := cleanRefs(userRefs(&user, field), existing[owned.entity])
+			for _, issue := range issues {
+				issue.Entity, issue.Owner, issue.OwnerID, issue.Field = owned.entity, "user", user.ID.Hex(), field
+				report.add(issue, write)
+			}
+			for _, id := range kept {
+				referenced[id] = struct{}{}
+			}
+			cleaned[i] = kept
+			changed[i] = len(issues) > 0
+		}
+
+		var orphans []primitive.ObjectID
+		for id := range existing[owned.entity] {
+			if _, ok := referenced[id]; !ok {
+				orphans = append(orphans, id)
+			}
+		}
+		sort.Slice(orphans, func(i, j int) bool { return orphans[i].Hex() < orphans[j].Hex() })
+		for _, id := range orphans {
+			issue := IntegrityIssue{Kind: IssueOrphan, Entity: owned.entity, ID: id.Hex(), Owner: "user", Field: field}
+			if len(users) > 0 {
+				issue.OwnerID = users[0].ID.Hex()
+			}
+			report.add(issue, write && len(users) > 0)
+		}
+
+		if !write {
+			continue
+		}
+
+		for i, user := range users {
+			refs := cleaned[i]
+			if i == 0 && len(orphans) > 0 {
+				refs = append(refs, orphans...)
+				changed[i] = true
+			}
+			if !changed[i] {
+				continue
+			}
+			if _, err := mgm.Coll(&models.User{}).UpdateByID(ctx, user.ID, bson.M{"$set": bson.M{field: refs}}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Project references held by other entities
+	for collName, refs := range references {
+		target := entityForColl(collName)
+		for _, ref := range refs {
+			var docs []refDoc
+			pipeline := bson.A{
+				bson.M{"$match": bson.M{ref.field + ".0": bson.M{"$exists": true}}},
+				bson.M{"$project": bson.M{"refs": "$" + ref.field}},
+			}
+			if err := mgm.Coll(ref.model).SimpleAggregateWithCtx(ctx, &docs, pipeline...); err != nil {
+				return nil, err
+			}
+
+			for _, doc := range docs {
+				kept, issues := cleanRefs(doc.Refs, existing[target])
+				if len(issues) == 0 {
+					continue
+				}
+				for _, issue := range issues {
+					issue.Entity, issue.Owner, issue.OwnerID, issue.Field = target, ref.entity, doc.ID.Hex(), ref.field
+					report.add(issue, write)
+				}
+				if write {
+					update := bson.M{"$set": bson.M{ref.field: kept, "updated_at": time.Now().UTC()}, "$inc": bson.M{"version": 1}}
+					if _, err := mgm.Coll(ref.model).UpdateByID(ctx, doc.ID, update); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func (r *IntegrityReport) add(issue IntegrityIssue, repaired bool) {
+	issue.Repaired = repaired
+	r.Summary[issue.Kind]++
+	r.Issues = append(r.Issues, issue)
+}
+
+// Rewritten lists the content documents whose references a repair changed.
+// User arrays are left out; they are not published content.
+func (r *IntegrityReport) Rewritten() []Referrer {
+	var docs []Referrer
+	seen := make(map[string]struct{})
+	for _, issue := range r.Issues {
+		if !issue.Repaired || issue.Owner == "user" {
+			continue
+		}
+		if _, ok := seen[issue.OwnerID]; ok {
+			continue
+		}
+		seen[issue.OwnerID] = struct{}{}
+		docs = append(docs, Referrer{Entity: issue.Owner, ID: issue.OwnerID})
+	}
+	return docs
+}
+
+// cleanRefs drops dangling and repeated IDs from refs, keeping the original order.
+func cleanRefs(refs []primitive.ObjectID, existing map[primitive.ObjectID]struct{}) ([]primitive.ObjectID, []IntegrityIssue) {
+	kept := make([]primitive.ObjectID, 0, len(refs))
+	seen := make(map[primitive.ObjectID]struct{}, len(refs))
+	var issues []IntegrityIssue
+
+	for _, id := range refs {
+		if _, ok := seen[id]; ok {
+			issues = append(issues, IntegrityIssue{Kind: IssueDuplicate, ID: id.Hex()})
+			continue
+		}
+		seen[id] = struct{}{}
+
+		if _, ok := existing[id]; !ok {
+			issues = append(issues, IntegrityIssue{Kind: IssueDangling, ID: id.Hex()})
+			continue
+		}
+		kept = append(kept, id)
+	}
+	return kept, issues
+}
+
+func allIDs(ctx context.Context, model mgm.Model) (map[primitive.ObjectID]struct{}, error) {
+	var docs []refDoc
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	if err := mgm.Coll(model).SimpleFindWithCtx(ctx, &docs, bson.M{}, opts); err != nil {
+		return nil, err
+	}
+
+	ids := make(map[primitive.ObjectID]struct{}, len(docs))
+	for _, doc := range docs {
+		ids[doc.ID] = struct{}{}
+	}
+	return ids, nil
+}
+
+func userRefs(user *models.User, field string) []primitive.ObjectID {
+	switch field {
+	case "projects":
+		return user.Projects
+	case "experiences":
+		return user.Experiences
+	case "certifications":
+		return user.Certifications
+	}
+	return nil
+}
+
+func entityForColl(collName string) string {
+	for _, owned := range ownedEntities {
+		if mgm.CollName(owned.model) == collName {
+			return owned.entity
+		}
+	}
+	return collName
+}
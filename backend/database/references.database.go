@@ -0,0 +1,175 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type DeletePolicy string
+
+const (
+	// DeleteCascade removes every reference to the deleted document.
+	DeleteCascade DeletePolicy = "cascade"
+	// DeleteRestrict refuses the delete while other documents reference it.
+	DeleteRestrict DeletePolicy = "restrict"
+)
+
+func ParseDeletePolicy(value string) (DeletePolicy, bool) {
+	switch DeletePolicy(value) {
+	case DeleteCascade, DeleteRestrict:
+		return DeletePolicy(value), true
+	}
+	return "", false
+}
+
+var ErrNotFound = errors.New("document not found")
+
+// ReferencedError is returned by DeleteWithPolicy under DeleteRestrict when
+// other documents still point at the one being deleted.
+type ReferencedError struct {
+	Referrers []Referrer
+}
+
+func (e *ReferencedError) Error() string {
+	return fmt.Sprintf("document is referenced by %d other document(s)", len(e.Referrers))
+}
+
+type Referrer struct {
+	Entity string `json:"entity"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+}
+
+// referenceField describes an array of ObjectIDs on another entity that may
+// point at documents of the target collection.
+type referenceField struct {
+	entity    string
+	model     mgm.Model
+	field     string
+	nameField string
+}
+
+// references maps a collection to the fields referencing it. These count
+// towards DeleteRestrict.
+var references = map[string][]referenceField{
+	mgm.CollName(&models.Project{}): {
+		{entity: "experience", model: &models.Experience{}, field: "projects", nameField: "company_name"},
+		{entity: "certification", model: &models.CertificationOrAchievements{}, field: "projects", nameField: "title"},
+	},
+}
+
+// ownerFields maps a collection to the User array that owns its documents.
+// Ownership is always cleaned up and never blocks a delete.
+var ownerFields = map[string]string{
+	mgm.CollName(&models.Project{}):                     "projects",
+	mgm.CollName(&models.Experience{}):                  "experiences",
+	mgm.CollName(&models.CertificationOrAchievements{}): "certifications",
+}
+
+// ReferencingEntities names the entities whose reference fields may point at
+// documents of model's collection, i.e. the ones a cascade delete rewrites.
+func ReferencingEntities(model mgm.Model) []string {
+	var entities []string
+	for _, ref := range references[mgm.CollName(model)] {
+		entities = append(entities, ref.entity)
+	}
+	return entities
+}
+
+// FindReferrers lists the documents whose reference fields contain id.
+func FindReferrers(ctx context.Context, model mgm.Model, id primitive.ObjectID) ([]Referrer, error) {
+	referrers := make([]Referrer, 0)
+	for _, ref := range references[mgm.CollName(model)] {
+		var docs []bson.M
+		opts := options.Find().SetProjection(bson.M{"_id": 1, ref.nameField: 1})
+		if err := mgm.Coll(ref.model).SimpleFindWithCtx(ctx, &docs, bson.M{ref.field: id}, opts); err != nil {
+			return nil, err
+		}
+
+		for _, doc := range docs {
+			docID, _ := doc["_id"].(primitive.ObjectID)
+			name, _ := doc[ref.nameField].(string)
+			referrers = append(referrers, Referrer{Entity: ref.entity, ID: docID.Hex(), Name: name})
+		}
+	}
+	return referrers, nil
+}
+
+// RemoveReferences pulls id out of every reference and owner array and
+// returns the referencing documents it changed. They get a new version, since
+// their content changed.
+func RemoveReferences(ctx context.Context, model mgm.Model, id primitive.ObjectID) ([]Referrer, error) {
+	referrers, err := FindReferrers(ctx, model, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range references[mgm.CollName(model)] {
+		update := bson.M{
+			"$pull": bson.M{ref.field: id},
+			"$set":  bson.M{"updated_at": time.Now().UTC()},
+			"$inc":  bson.M{"version": 1},
+		}
+		if _, err := mgm.Coll(ref.model).UpdateMany(ctx, bson.M{ref.field: id}, update); err != nil {
+			return nil, err
+		}
+	}
+
+	if field, ok := ownerFields[mgm.CollName(model)]; ok {
+		if _, err := mgm.Coll(&models.User{}).UpdateMany(ctx, bson.M{field: id}, bson.M{"$pull": bson.M{field: id}}); err != nil {
+			return nil, err
+		}
+	}
+	return referrers, nil
+}
+
+// DeleteWithPolicy deletes the document with the given id from model's
+// collection after applying policy to the documents that reference it, all
+// within one transaction where the deployment supports it.
+// It returns ErrNotFound if the document does not exist, a
+// *VersionMismatchError if expected is non-nil and does not contain the
+// stored version, and a *ReferencedError if the policy is DeleteRestrict and
+// references remain. On success it returns the documents a cascade rewrote.
+func DeleteWithPolicy(ctx context.Context, model mgm.Model, id primitive.ObjectID, policy DeletePolicy, expected []int64) ([]Referrer, error) {
+	var rewritten []Referrer
+	err := RunInTransaction(ctx, func(ctx context.Context) error {
+		coll := mgm.Coll(model)
+
+		if err := checkVersion(ctx, coll, id, expected); err != nil {
+			return err
+		}
+
+		if policy == DeleteRestrict {
+			referrers, err := FindReferrers(ctx, model, id)
+			if err != nil {
+				return err
+			}
+			if len(referrers) > 0 {
+				return &ReferencedError{Referrers: referrers}
+			}
+		}
+
+		// References go first so that, without a transaction, a failed delete
+		// leaves an unreferenced document rather than dangling IDs
+		var err error
+		// Reset on every try, since the driver may retry the transaction
+		if rewritten, err = RemoveReferences(ctx, model, id); err != nil {
+			return err
+		}
+
+		_, err = coll.DeleteOne(ctx, bson.M{"_id": id})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rewritten, nil
+}
@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var ErrNoUser = errors.New("no user found")
+
+var (
+	txnMu        sync.Mutex
+	txnKnown     bool
+	txnSupported bool
+)
+
+// txnProbeTimeout bounds the topology check, which must not depend on the
+// request that happens to trigger it.
+const txnProbeTimeout = 5 * time.Second
+
+// SupportsTransactions reports whether the connected deployment is a replica
+// set or sharded cluster. The answer is cached once the topology has been
+// detected; while it cannot be, writes run without a transaction and the
+// next call probes again.
+func SupportsTransactions() bool {
+	txnMu.Lock()
+	defer txnMu.Unlock()
+	if txnKnown {
+		return txnSupported
+	}
+
+	_, client, _, err := mgm.DefaultConfigs()
+	if err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), txnProbeTimeout)
+	defer cancel()
+	var hello bson.M
+	if err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "isMaster", Value: 1}}).Decode(&hello); err != nil {
+		slog.Warn("Could not detect MongoDB topology, running this write without a transaction", "error", err)
+		return false
+	}
+
+	_, replicaSet := hello["setName"]
+	txnKnown, txnSupported = true, replicaSet || hello["msg"] == "isdbgrid"
+	if !txnSupported {
+		slog.Warn("MongoDB is not a replica set, multi-document writes run without transactions")
+	}
+	return txnSupported
+}
+
+// RunInTransaction runs fn inside a multi-document transaction. The driver
+// retries the whole callback on TransientTransactionError and the commit on
+// UnknownTransactionCommitResult, so fn must be safe to run more than once.
+//
+// Standalone servers cannot run transactions. There fn is called once with
+// the plain context and is expected to order its writes so that a partial
+// failure is compensated (see CreateOwned) or repairable by the integrity
+// checker.
+func RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !SupportsTransactions() {
+		return fn(ctx)
+	}
+
+	_, client, _, err := mgm.DefaultConfigs()
+	if err != nil {
+		return err
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
+
+// CreateOwned inserts model and records its ID on the owning user in one
+// transaction. Without transaction support the insert is undone by hand if
+// the user update fails.
+func CreateOwned(ctx context.Context, model mgm.Model) error {
+	field := ownerFields[mgm.CollName(model)]
+
+	return RunInTransaction(ctx, func(ctx context.Context) error {
+		// Since there's only one user, the first user owns everything
+		var user models.User
+		userColl := mgm.Coll(&models.User{})
+		if err := userColl.FirstWithCtx(ctx, bson.M{}, &user); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return ErrNoUser
+			}
+			return err
+		}
+
+		if err := mgm.Coll(model).CreateWithCtx(ctx, model); err != nil {
+			return err
+		}
+
+		if err := addOwnerRef(ctx, user, field, model.GetID()); err != nil {
+			if mongo.SessionFromContext(ctx) == nil {
+				_, _ = mgm.Coll(model).DeleteOne(ctx, bson.M{"_id": model.GetID()})
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func addOwnerRef(ctx context.Context, user models.User, field string, id interface{}) error {
+	userColl := mgm.Coll(&models.User{})
+
+	// Users created without any entities store null arrays, which $addToSet rejects
+	if _, err := userColl.UpdateOne(ctx, bson.M{"_id": user.ID, field: nil}, bson.M{"$set": bson.M{field: bson.A{}}}); err != nil {
+		return err
+	}
+
+	_, err := userColl.UpdateByID(ctx, user.ID, bson.M{"$addToSet": bson.M{field: id}})
+	return err
+}
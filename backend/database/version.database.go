@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// VersionMismatchError is returned when a write names versions (from
+// If-Match) and the stored document has moved on.
+type VersionMismatchError struct {
+	Current int64
+}
+
+func (e *VersionMismatchError) Error() string {
+	return fmt.Sprintf("document is at version %d", e.Current)
+}
+
+// currentVersion loads the stored version of the document, or ErrNotFound.
+func currentVersion(ctx context.Context, coll *mgm.Collection, id primitive.ObjectID) (int64, error) {
+	var current struct {
+		Version int64 `bson:"version"`
+	}
+	opts := options.FindOne().SetProjection(bson.M{"version": 1})
+	if err := coll.FindOne(ctx, bson.M{"_id": id}, opts).Decode(&current); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+	return current.Version, nil
+}
+
+// checkVersion loads the stored version of the document and compares it with
+// expected. A nil expected slice accepts any version.
+func checkVersion(ctx context.Context, coll *mgm.Collection, id primitive.ObjectID, expected []int64) error {
+	current, err := currentVersion(ctx, coll, id)
+	if err != nil {
+		return err
+	}
+	if expected == nil || slices.Contains(expected, current) {
+		return nil
+	}
+	return &VersionMismatchError{Current: current}
+}
+
+// UpdateVersioned applies set to the document with id, bumps its version and
+// decodes the updated document into model. With a non-nil expected the update
+// only happens if the stored version is one of them.
+func UpdateVersioned(ctx context.Context, model mgm.Model, id primitive.ObjectID, set bson.M, expected []int64) error {
+	return updateVersioned(ctx, mgm.Coll(model), model, id, set, expected)
+}
+
+func updateVersioned(ctx context.Context, coll *mgm.Collection, model mgm.Model, id primitive.ObjectID, set bson.M, expected []int64) error {
+	filter := bson.M{"_id": id}
+	if expected != nil {
+		filter["version"] = bson.M{"$in": expected}
+	}
+
+	set["updated_at"] = time.Now().UTC()
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	for attempt := 0; ; attempt++ {
+		err := coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(model)
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return err
+		}
+
+		// Either the document is gone or its version did not match
+		current, err := currentVersion(ctx, coll, id)
+		if err != nil {
+			return err
+		}
+		if attempt > 0 || (expected != nil && !slices.Contains(expected, current)) {
+			return &VersionMismatchError{Current: current}
+		}
+		// The version changed between the update and the read; try once more
+	}
+}
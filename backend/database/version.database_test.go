@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestUpdateVersionedMissedUpdate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	id := primitive.NewObjectID()
+	ns := "portfolio.projects"
+	// findAndModify answers without a document when the filter misses
+	miss := mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil})
+	hit := mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.D{{Key: "_id", Value: id}, {Key: "version", Value: int64(6)}}})
+	version := func(v int64) bson.D {
+		return mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, bson.D{{Key: "_id", Value: id}, {Key: "version", Value: v}})
+	}
+	gone := mtest.CreateCursorResponse(0, ns, mtest.FirstBatch)
+
+	tests := []struct {
+		name      string
+		expected  []int64
+		responses []bson.D
+		want      int64 // Current of the mismatch, or -1 for success
+		wantErr   error
+	}{
+		{"stale version", []int64{3}, []bson.D{miss, version(5)}, 5, nil},
+		// The document reached an expected version right after the update missed
+		{"race then success", []int64{5}, []bson.D{miss, version(5), hit}, -1, nil},
+		{"race twice", []int64{5}, []bson.D{miss, version(5), miss, version(7)}, 7, nil},
+		{"deleted", []int64{5}, []bson.D{miss, gone}, 0, ErrNotFound},
+		{"no precondition", nil, []bson.D{miss, gone}, 0, ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			mt.AddMockResponses(tt.responses...)
+			coll := mgm.NewCollection(mt.DB, "projects")
+
+			var project models.Project
+			err := updateVersioned(context.Background(), coll, &project, id, bson.M{"project_name": "x"}, tt.expected)
+
+			var mismatch *VersionMismatchError
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					mt.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+			case tt.want < 0:
+				if err != nil || project.Version != 6 {
+					mt.Fatalf("err = %v, version = %d, want success at 6", err, project.Version)
+				}
+			case !errors.As(err, &mismatch):
+				mt.Fatalf("err = %v, want a version mismatch", err)
+			case mismatch.Current != tt.want:
+				mt.Fatalf("Current = %d, want %d", mismatch.Current, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package events
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Entities whose changes are published.
+const (
+	Project       = "project"
+	Experience    = "experience"
+	Certification = "certification"
+	Skills        = "skills"
+	// Stats are the cached GitHub and LeetCode responses. They are not
+	// portfolio content, so webhooks and the gRPC watch stream skip them.
+	Stats = "stats"
+)
+
+// IsContent reports whether entity is portfolio content rather than stats.
+func IsContent(entity string) bool {
+	return entity != Stats
+}
+
+// Actions a change can describe.
+const (
+	Created = "created"
+	Updated = "updated"
+	Deleted = "deleted"
+	// Refreshed is published when a stats response is fetched again.
+	Refreshed = "refreshed"
+)
+
+// Event describes one change to the portfolio content.
+type Event struct {
+	// ID increases by one for every event published on a bus. IDs start at
+	// the bus's creation time in Unix milliseconds, so they keep increasing
+	// across restarts.
+	ID uint64 `json:"id"`
+	// Type is "<entity>.<action>", e.g. "project.created".
+	Type     string `json:"type"`
+	Entity   string `json:"entity"`
+	Action   string `json:"action"`
+	EntityID string `json:"entity_id,omitempty"`
+	// Data is the entity's response DTO after the change, nil for deletes.
+	// For stats it is the refreshed response body.
+	Data any       `json:"data,omitempty"`
+	At   time.Time `json:"at"`
+}
+
+// Bus fans events out to subscribers in this process. Publishing never
+// blocks: a subscriber that falls a full buffer behind is dropped and its
+// channel closed, so it can resubscribe and reload what it missed. The most
+// recent events are kept so that subscribers can resume after a disconnect.
+type Bus struct {
+	mu      sync.Mutex
+	nextID  uint64
+	subs    map[chan Event]struct{}
+	history []Event
+}
+
+// historySize is how many recent events a bus keeps for SubscribeFrom.
+const historySize = 512
+
+func NewBus() *Bus {
+	return &Bus{
+		nextID: uint64(time.Now().UnixMilli()),
+		subs:   make(map[chan Event]struct{}),
+	}
+}
+
+// Default is the bus the controllers publish content changes on.
+var Default = NewBus()
+
+// Publish sends an event for entity to every subscriber and returns it.
+func (b *Bus) Publish(entity, action, entityID string, data any) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	e := Event{
+		ID:       b.nextID,
+		Type:     entity + "." + action,
+		Entity:   entity,
+		Action:   action,
+		EntityID: entityID,
+		Data:     data,
+		At:       time.Now().UTC(),
+	}
+
+	b.history = append(b.history, e)
+	if len(b.history) > historySize {
+		b.history = b.history[1:]
+	}
+
+	for ch := range b.subs {
+		select {
+		case ch <- e:
+		default:
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+	return e
+}
+
+// Subscribe returns a channel receiving every event published from now on
+// and a function that ends the subscription. Calling it more than once is
+// safe.
+func (b *Bus) Subscribe(buffer int) (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.subscribeLocked(buffer)
+}
+
+func (b *Bus) subscribeLocked(buffer int) (<-chan Event, func()) {
+	ch := make(chan Event, buffer)
+	b.subs[ch] = struct{}{}
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subs[ch]; ok {
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// SubscribeFrom is Subscribe for a subscriber that has seen every event up
+// to lastID. It also returns the events published since then; complete is
+// false when some of them are no longer kept, or lastID was not issued by
+// this bus, and the subscriber should reload instead.
+func (b *Bus) SubscribeFrom(lastID uint64, buffer int) (missed []Event, complete bool, ch <-chan Event, unsubscribe func()) {
+	b.mu.Lock()
+	latest := b.nextID
+	switch {
+	case lastID == latest:
+		complete = true
+	case lastID < latest && len(b.history) > 0 && lastID+1 >= b.history[0].ID:
+		complete = true
+		missed = append(missed, b.history[lastID+1-b.history[0].ID:]...)
+	}
+	// Subscribing before unlocking means no event falls between missed and ch
+	ch, unsubscribe = b.subscribeLocked(buffer)
+	b.mu.Unlock()
+	return missed, complete, ch, unsubscribe
+}
+
+// Publish publishes on the Default bus.
+func Publish(entity, action, entityID string, data any) Event {
+	return Default.Publish(entity, action, entityID, data)
+}
+
+// Matches reports whether eventType is selected by pattern, which is an
+// event type, "<entity>.*" or "*".
+func Matches(pattern, eventType string) bool {
+	if pattern == "*" || pattern == eventType {
+		return true
+	}
+	entity, ok := strings.CutSuffix(pattern, ".*")
+	return ok && strings.HasPrefix(eventType, entity+".")
+}
+
+// ValidPattern reports whether pattern can match any published event.
+func ValidPattern(pattern string) bool {
+	if pattern == "*" {
+		return true
+	}
+	entity, action, ok := strings.Cut(pattern, ".")
+	if !ok {
+		return false
+	}
+	switch entity {
+	case Project, Experience, Certification:
+		return action == "*" || action == Created || action == Updated || action == Deleted
+	case Skills:
+		return action == "*" || action == Updated
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package events
+
+import "testing"
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		eventType string
+		want      bool
+	}{
+		{"*", "project.created", true},
+		{"*", "stats.refreshed", true},
+		{"project.created", "project.created", true},
+		{"project.created", "project.updated", false},
+		{"project.*", "project.deleted", true},
+		{"project.*", "experience.created", false},
+		// An entity prefix has to end at the dot
+		{"project.*", "projects.created", false},
+		{"project", "project.created", false},
+		{"", "project.created", false},
+	}
+
+	for _, tt := range tests {
+		if got := Matches(tt.pattern, tt.eventType); got != tt.want {
+			t.Errorf("Matches(%q, %q) = %v, want %v", tt.pattern, tt.eventType, got, tt.want)
+		}
+	}
+}
+
+func TestValidPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    bool
+	}{
+		{"*", true},
+		{"project.*", true},
+		{"experience.created", true},
+		{"certification.deleted", true},
+		{"skills.updated", true},
+		{"skills.*", true},
+		{"skills.created", false},
+		{"project.refreshed", false},
+		{"project", false},
+		{"unknown.*", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidPattern(tt.pattern); got != tt.want {
+			t.Errorf("ValidPattern(%q) = %v, want %v", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestSubscribeFrom(t *testing.T) {
+	tests := []struct {
+		name         string
+		published    int
+		lastID       func(start uint64) uint64
+		wantComplete bool
+		// The missed events, as an offset from the bus's start ID and a count
+		wantFirst uint64
+		wantCount int
+	}{
+		{"up to date, nothing published", 0, func(s uint64) uint64 { return s }, true, 0, 0},
+		{"up to date", 3, func(s uint64) uint64 { return s + 3 }, true, 0, 0},
+		{"behind", 3, func(s uint64) uint64 { return s + 1 }, true, 2, 2},
+		{"saw nothing yet", 3, func(s uint64) uint64 { return s }, true, 1, 3},
+		{"from another bus", 3, func(s uint64) uint64 { return 42 }, false, 0, 0},
+		{"ahead of the bus", 3, func(s uint64) uint64 { return s + 10 }, false, 0, 0},
+		// The oldest five events were dropped from the history
+		{"too far behind", historySize + 5, func(s uint64) uint64 { return s + 1 }, false, 0, 0},
+		{"oldest kept", historySize + 5, func(s uint64) uint64 { return s + 5 }, true, 6, historySize},
+	}
+
+	for _, tt := range tests {
+		b := NewBus()
+		start := b.nextID
+		for range tt.published {
+			b.Publish(Project, Updated, "p", nil)
+		}
+
+		missed, complete, ch, unsubscribe := b.SubscribeFrom(tt.lastID(start), 4)
+		if complete != tt.wantComplete {
+			t.Errorf("%s: complete = %v, want %v", tt.name, complete, tt.wantComplete)
+		}
+		if len(missed) != tt.wantCount {
+			t.Errorf("%s: missed %d events, want %d", tt.name, len(missed), tt.wantCount)
+		}
+		for i, e := range missed {
+			if want := start + tt.wantFirst + uint64(i); e.ID != want {
+				t.Errorf("%s: missed[%d].ID = %d, want %d", tt.name, i, e.ID, want)
+				break
+			}
+		}
+
+		// Events published after subscribing follow on the channel
+		e := b.Publish(Project, Deleted, "p", nil)
+		if got := <-ch; got.ID != e.ID {
+			t.Errorf("%s: received event %d, want %d", tt.name, got.ID, e.ID)
+		}
+		unsubscribe()
+	}
+}
@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Options configures the GraphQL endpoint.
+type Options struct {
+	// MaxDepth and MaxComplexity reject queries before execution. Zero
+	// disables a limit.
+	MaxDepth      int
+	MaxComplexity int
+	// ExpiryWindowDays is used to compute certification status.
+	ExpiryWindowDays int
+}
+
+// Request is the body of a GraphQL POST, as sent by standard clients.
+type Request struct {
+	Query         string         `json:"query" validate:"required"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Handler serves read-only GraphQL queries over the portfolio content. Query
+// errors are returned in the GraphQL `errors` array rather than the REST
+// error envelope, so generic GraphQL clients can read them. It fails only if
+// the schema cannot be built, which is a programming error.
+func Handler(opts Options) (fiber.Handler, error) {
+	schema, err := newSchema(opts.ExpiryWindowDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(c *fiber.Ctx) error {
+		var req Request
+		if c.Method() == fiber.MethodGet {
+			req.Query = c.Query("query")
+			req.OperationName = c.Query("operationName")
+			if vars := c.Query("variables"); vars != "" {
+				if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+					return util.ErrorAPI(c, fiber.StatusBadRequest, util.CodeBadRequest, "Invalid variables", nil)
+				}
+			}
+		} else if err := c.BodyParser(&req); err != nil {
+			return util.ErrorAPI(c, fiber.StatusBadRequest, util.CodeBadRequest, "Invalid request body", nil)
+		}
+
+		if fields := util.Validate(req); fields != nil {
+			return util.ErrorAPI(c, fiber.StatusUnprocessableEntity, util.CodeValidationFailed, "Validation failed", fields)
+		}
+
+		doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"})})
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(&graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+		}
+
+		if validation := graphql.ValidateDocument(&schema, doc, nil); !validation.IsValid {
+			return c.Status(fiber.StatusBadRequest).JSON(&graphql.Result{Errors: validation.Errors})
+		}
+
+		if err := checkLimits(schema, doc, opts.MaxDepth, opts.MaxComplexity); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(&graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+		}
+
+		ctx := context.WithValue(c.Context(), loaderKey{}, newProjectLoader(c.Context()))
+		result := graphql.Execute(graphql.ExecuteParams{
+			Schema:        schema,
+			AST:           doc,
+			OperationName: req.OperationName,
+			Args:          req.Variables,
+			Context:       ctx,
+		})
+		return c.JSON(result)
+	}, nil
+}
@@ -0,0 +1,9 @@
+package graph
+
+import "testing"
+
+func TestHandlerBuildsSchema(t *testing.T) {
+	if _, err := Handler(Options{ExpiryWindowDays: 30}); err != nil {
+		t.Fatalf("Handler: %v", err)
+	}
+}
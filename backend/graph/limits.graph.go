@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// listCost is how many times a list field's selections are counted, as an
+// estimate of the items it returns.
+const listCost = 10
+
+// analysis walks a parsed query against the schema to measure its depth and
+// complexity before anything is executed. Introspection fields are exempt so
+// explorers keep working.
+type analysis struct {
+	schema    graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	visiting  map[string]bool
+}
+
+// checkLimits returns an error when any operation in doc is deeper than
+// maxDepth or costs more than maxComplexity. Zero disables a limit.
+func checkLimits(schema graphql.Schema, doc *ast.Document, maxDepth, maxComplexity int) error {
+	a := analysis{schema: schema, fragments: map[string]*ast.FragmentDefinition{}, visiting: map[string]bool{}}
+	for _, def := range doc.Definitions {
+		if frag, ok := def.(*ast.FragmentDefinition); ok {
+			a.fragments[frag.Name.Value] = frag
+		}
+	}
+
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if op.Operation != ast.OperationTypeQuery {
+			return fmt.Errorf("only queries are supported, got %s", op.Operation)
+		}
+
+		depth, cost := a.selections(op.SelectionSet, schema.QueryType(), 1)
+		if maxDepth > 0 && depth > maxDepth {
+			return fmt.Errorf("query depth %d exceeds the limit of %d", depth, maxDepth)
+		}
+		if maxComplexity > 0 && cost > maxComplexity {
+			return fmt.Errorf("query complexity %d exceeds the limit of %d", cost, maxComplexity)
+		}
+	}
+	return nil
+}
+
+func (a analysis) selections(set *ast.SelectionSet, parent *graphql.Object, level int) (depth, cost int) {
+	if set == nil || parent == nil {
+		return 0, 0
+	}
+
+	for _, sel := range set.Selections {
+		var d, c int
+		switch s := sel.(type) {
+		case *ast.Field:
+			d, c = a.field(s, parent, level)
+		case *ast.InlineFragment:
+			d, c = a.selections(s.SelectionSet, a.condition(s.TypeCondition, parent), level)
+		case *ast.FragmentSpread:
+			frag := a.fragments[s.Name.Value]
+			if frag == nil || a.visiting[frag.Name.Value] {
+				continue
+			}
+			a.visiting[frag.Name.Value] = true
+			d, c = a.selections(frag.SelectionSet, a.condition(frag.TypeCondition, parent), level)
+			delete(a.visiting, frag.Name.Value)
+		}
+		depth = max(depth, d)
+		cost += c
+	}
+	return depth, cost
+}
+
+func (a analysis) field(f *ast.Field, parent *graphql.Object, level int) (depth, cost int) {
+	if strings.HasPrefix(f.Name.Value, "__") {
+		return 0, 0
+	}
+
+	def := parent.Fields()[f.Name.Value]
+	if def == nil {
+		// Unknown fields are reported by validation during execution
+		return level, 1
+	}
+
+	child, multiplier := unwrap(def.Type)
+	childDepth, childCost := a.selections(f.SelectionSet, child, level+1)
+	return max(level, childDepth), 1 + multiplier*childCost
+}
+
+func (a analysis) condition(named *ast.Named, parent *graphql.Object) *graphql.Object {
+	if named == nil {
+		return parent
+	}
+	if obj, ok := a.schema.Type(named.Name.Value).(*graphql.Object); ok {
+		return obj
+	}
+	return parent
+}
+
+// unwrap returns the object type behind NonNull and List wrappers, nil for
+// scalars and enums, and the cost multiplier of the lists it passed through.
+func unwrap(t graphql.Type) (*graphql.Object, int) {
+	multiplier := 1
+	for {
+		switch typ := t.(type) {
+		case *graphql.NonNull:
+			t = typ.OfType
+		case *graphql.List:
+			multiplier *= listCost
+			t = typ.OfType
+		case *graphql.Object:
+			return typ, multiplier
+		default:
+			return nil, multiplier
+		}
+	}
+}
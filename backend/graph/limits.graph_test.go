@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+func limitsSchema(t *testing.T) graphql.Schema {
+	project := graphql.NewObject(graphql.ObjectConfig{
+		Name:   "Project",
+		Fields: graphql.Fields{"name": &graphql.Field{Type: graphql.String}},
+	})
+	project.AddFieldConfig("owner", &graphql.Field{Type: project})
+	project.AddFieldConfig("related", &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(project)))})
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"name":     &graphql.Field{Type: graphql.String},
+				"project":  &graphql.Field{Type: project},
+				"projects": &graphql.Field{Type: graphql.NewList(project)},
+			},
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Mutation",
+			Fields: graphql.Fields{"name": &graphql.Field{Type: graphql.String}},
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+func TestCheckLimits(t *testing.T) {
+	schema := limitsSchema(t)
+
+	tests := []struct {
+		name          string
+		query         string
+		maxDepth      int
+		maxComplexity int
+		wantErr       string
+	}{
+		{"scalar", `{ name }`, 1, 1, ""},
+		{"nested object", `{ project { name } }`, 2, 2, ""},
+		{"too deep", `{ project { owner { name } } }`, 2, 0, "query depth 3 exceeds the limit of 2"},
+		// A list multiplies the cost of its selections by listCost
+		{"list", `{ projects { name } }`, 0, 11, ""},
+		{"list over budget", `{ projects { name } }`, 0, 10, "query complexity 11 exceeds the limit of 10"},
+		{"nested lists", `{ projects { related { name } } }`, 3, 111, ""},
+		{"nested lists over budget", `{ projects { related { name } } }`, 0, 110, "query complexity 111 exceeds the limit of 110"},
+		{"limits disabled", `{ projects { related { related { owner { name } } } } }`, 0, 0, ""},
+		{"sibling costs add up", `{ name project { name } }`, 0, 2, "query complexity 3 exceeds the limit of 2"},
+		{"fragment spread", `{ project { ...F } } fragment F on Project { name owner { name } }`, 2, 0, "query depth 3 exceeds the limit of 2"},
+		{"fragment spread cost", `{ project { ...F } } fragment F on Project { name owner { name } }`, 3, 3, "query complexity 4 exceeds the limit of 3"},
+		{"inline fragment", `{ project { ... on Project { name } } }`, 2, 2, ""},
+		// Cycles are rejected by validation; analysis must still terminate
+		{"recursive fragment", `{ project { ...F } } fragment F on Project { name owner { ...F } }`, 3, 3, ""},
+		{"introspection is free", `{ __schema { types { name fields { name } } } }`, 1, 1, ""},
+		{"each operation is checked", `query A { name } query B { project { owner { name } } }`, 2, 0, "query depth 3 exceeds the limit of 2"},
+		{"mutation", `mutation { name }`, 0, 0, "only queries are supported"},
+	}
+
+	for _, tt := range tests {
+		doc, err := parser.Parse(parser.ParseParams{Source: tt.query})
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		err = checkLimits(schema, doc, tt.maxDepth, tt.maxComplexity)
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("%s: err = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type loaderKey struct{}
+
+// projectLoader batches project lookups for one request. Resolvers queue IDs
+// with Load and get a thunk back; graphql-go runs thunks only after every
+// sibling field has been resolved, so the first thunk fetches all queued IDs
+// in a single query and the rest are answered from the cache.
+type projectLoader struct {
+	ctx     context.Context
+	mu      sync.Mutex
+	pending map[primitive.ObjectID]struct{}
+	loaded  map[primitive.ObjectID]*models.Project
+}
+
+func newProjectLoader(ctx context.Context) *projectLoader {
+	return &projectLoader{
+		ctx:     ctx,
+		pending: make(map[primitive.ObjectID]struct{}),
+		loaded:  make(map[primitive.ObjectID]*models.Project),
+	}
+}
+
+func loaderFrom(ctx context.Context) *projectLoader {
+	if l, ok := ctx.Value(loaderKey{}).(*projectLoader); ok {
+		return l
+	}
+	return newProjectLoader(ctx)
+}
+
+// Load queues ids and returns a thunk resolving to the projects that still
+// exist, in the order of ids.
+func (l *projectLoader) Load(ids []primitive.ObjectID) func() (interface{}, error) {
+	l.mu.Lock()
+	for _, id := range ids {
+		if _, ok := l.loaded[id]; !ok {
+			l.pending[id] = struct{}{}
+		}
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		if err := l.flush(); err != nil {
+			return nil, err
+		}
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		projects := make([]models.ProjectResponse, 0, len(ids))
+		for _, id := range ids {
+			if p := l.loaded[id]; p != nil {
+				projects = append(projects, p.Response())
+			}
+		}
+		return projects, nil
+	}
+}
+
+// Prime stores projects that were already fetched, so later Loads of them
+// need no query.
+func (l *projectLoader) Prime(projects []models.Project) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range projects {
+		l.loaded[projects[i].ID] = &projects[i]
+		delete(l.pending, projects[i].ID)
+	}
+}
+
+func (l *projectLoader) flush() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.pending) == 0 {
+		return nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(l.pending))
+	for id := range l.pending {
+		ids = append(ids, id)
+	}
+
+	projects, err := database.Projects(l.ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		// Missing IDs are remembered as nil so they are not queried again
+		l.loaded[id] = nil
+	}
+	for i := range projects {
+		l.loaded[projects[i].ID] = &projects[i]
+	}
+	l.pending = make(map[primitive.ObjectID]struct{})
+	return nil
+}
@@ -0,0 +1,253 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/models"
+	"github.com/graphql-go/graphql"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Field names follow the REST JSON names so both APIs describe the same
+// resources the same way. Resolvers return the REST response DTOs.
+
+type skill struct {
+	Name     string                   `json:"name"`
+	Projects []models.ProjectResponse `json:"projects"`
+}
+
+func newSchema(expiryWindowDays int) (graphql.Schema, error) {
+	timestamps := func(fields graphql.Fields) graphql.Fields {
+		fields["id"] = &graphql.Field{Type: graphql.NewNonNull(graphql.ID)}
+		fields["version"] = &graphql.Field{Type: graphql.Int}
+		fields["created_at"] = &graphql.Field{Type: graphql.DateTime}
+		fields["updated_at"] = &graphql.Field{Type: graphql.DateTime}
+		return fields
+	}
+	strings := graphql.NewList(graphql.NewNonNull(graphql.String))
+
+	project := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Project",
+		Fields: timestamps(graphql.Fields{
+			"project_name":       &graphql.Field{Type: graphql.String},
+			"small_description":  &graphql.Field{Type: graphql.String},
+			"description":        &graphql.Field{Type: graphql.String},
+			"skills":             &graphql.Field{Type: strings},
+			"project_repository": &graphql.Field{Type: graphql.String},
+			"project_live_link":  &graphql.Field{Type: graphql.String},
+			"project_video":      &graphql.Field{Type: graphql.String},
+		}),
+	})
+	projects := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(project)))
+
+	experience := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Experience",
+		Fields: timestamps(graphql.Fields{
+			"company_name":    &graphql.Field{Type: graphql.String},
+			"position":        &graphql.Field{Type: graphql.String},
+			"start_date":      &graphql.Field{Type: graphql.String},
+			"end_date":        &graphql.Field{Type: graphql.String},
+			"description":     &graphql.Field{Type: graphql.String},
+			"technologies":    &graphql.Field{Type: strings},
+			"created_by":      &graphql.Field{Type: graphql.String},
+			"company_logo":    &graphql.Field{Type: graphql.String},
+			"certificate_url": &graphql.Field{Type: graphql.String},
+			"images":          &graphql.Field{Type: strings},
+			"projects": &graphql.Field{
+				Type: projects,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loaderFrom(p.Context).Load(p.Source.(models.ExperienceResponse).Projects), nil
+				},
+			},
+		}),
+	})
+
+	status := graphql.NewEnum(graphql.EnumConfig{
+		Name: "CertificationStatus",
+		Values: graphql.EnumValueConfigMap{
+			"active":        &graphql.EnumValueConfig{Value: models.CertificationActive},
+			"expiring_soon": &graphql.EnumValueConfig{Value: models.CertificationExpiringSoon},
+			"expired":       &graphql.EnumValueConfig{Value: models.CertificationExpired},
+			"no_expiry":     &graphql.EnumValueConfig{Value: models.CertificationNoExpiry},
+		},
+	})
+
+	certification := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Certification",
+		Fields: timestamps(graphql.Fields{
+			"title":           &graphql.Field{Type: graphql.String},
+			"description":     &graphql.Field{Type: graphql.String},
+			"skills":          &graphql.Field{Type: strings},
+			"certificate_url": &graphql.Field{Type: graphql.String},
+			"images":          &graphql.Field{Type: strings},
+			"issuer":          &graphql.Field{Type: graphql.String},
+			"issue_date":      &graphql.Field{Type: graphql.String},
+			"expiry_date":     &graphql.Field{Type: graphql.String},
+			"status":          &graphql.Field{Type: status},
+			"projects": &graphql.Field{
+				Type: projects,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loaderFrom(p.Context).Load(p.Source.(models.CertificationResponse).Projects), nil
+				},
+			},
+		}),
+	})
+
+	skillType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Skill",
+		Fields: graphql.Fields{
+			"name":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"projects": &graphql.Field{Type: projects},
+		},
+	})
+
+	byID := graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"projects": &graphql.Field{
+				Type: projects,
+				Args: graphql.FieldConfigArgument{"skill": &graphql.ArgumentConfig{Type: graphql.String}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					filter := bson.M{}
+					if s, ok := p.Args["skill"].(string); ok {
+						filter["skills"] = s
+					}
+					list, err := database.Projects(p.Context, filter)
+					if err != nil {
+						return nil, err
+					}
+					loaderFrom(p.Context).Prime(list)
+					return models.ProjectResponses(list), nil
+				},
+			},
+			"project": &graphql.Field{
+				Type: project,
+				Args: byID,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := idArg(p)
+					if err != nil {
+						return nil, err
+					}
+					found, err := database.ProjectByID(p.Context, id)
+					if err != nil {
+						return notFound(err)
+					}
+					return found.Response(), nil
+				},
+			},
+			"experiences": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(experience))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					list, err := database.Experiences(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return models.ExperienceResponses(list), nil
+				},
+			},
+			"experience": &graphql.Field{
+				Type: experience,
+				Args: byID,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := idArg(p)
+					if err != nil {
+						return nil, err
+					}
+					found, err := database.ExperienceByID(p.Context, id)
+					if err != nil {
+						return notFound(err)
+					}
+					return found.Response(), nil
+				},
+			},
+			"certifications": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(certification))),
+				Args: graphql.FieldConfigArgument{"status": &graphql.ArgumentConfig{Type: status}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					status, _ := p.Args["status"].(string)
+					list, err := database.Certifications(p.Context, time.Now(), expiryWindowDays, status)
+					if err != nil {
+						return nil, err
+					}
+					return models.CertificationResponses(list), nil
+				},
+			},
+			"certification": &graphql.Field{
+				Type: certification,
+				Args: byID,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, err := idArg(p)
+					if err != nil {
+						return nil, err
+					}
+					found, err := database.CertificationByID(p.Context, id, time.Now(), expiryWindowDays)
+					if err != nil {
+						return notFound(err)
+					}
+					return found.Response(), nil
+				},
+			},
+			"skills": &graphql.Field{
+				Type:    graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(skillType))),
+				Resolve: resolveSkills,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// idArg parses the `id` argument.
+func idArg(p graphql.ResolveParams) (primitive.ObjectID, error) {
+	id, err := primitive.ObjectIDFromHex(p.Args["id"].(string))
+	if err != nil {
+		return id, errors.New("invalid id")
+	}
+	return id, nil
+}
+
+// notFound turns a missing document into a null result.
+func notFound(err error) (interface{}, error) {
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, nil
+	}
+	return nil, err
+}
+
+// resolveSkills groups the user's projects by skill, matching GET /skills.
+func resolveSkills(p graphql.ResolveParams) (interface{}, error) {
+	filter, err := database.SkillsFilter(p.Context)
+	if errors.Is(err, database.ErrNoUser) {
+		return []skill{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	list, err := database.Projects(p.Context, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	bySkill := map[string]*skill{}
+	for _, proj := range list {
+		for _, name := range proj.Skills {
+			if bySkill[name] == nil {
+				bySkill[name] = &skill{Name: name, Projects: []models.ProjectResponse{}}
+			}
+			bySkill[name].Projects = append(bySkill[name].Projects, proj.Response())
+		}
+	}
+
+	skills := make([]skill, 0, len(bySkill))
+	for _, s := range bySkill {
+		skills = append(skills, *s)
+	}
+	sort.Slice(skills, func(i, j int) bool { return skills[i].Name < skills[j].Name })
+	return skills, nil
+}
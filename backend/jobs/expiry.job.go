@@ -0,0 +1,99 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/notifier"
+)
+
+type expiringCertification struct {
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	Issuer     string `json:"issuer"`
+	ExpiryDate string `json:"expiry_date"`
+	DaysLeft   int    `json:"days_left"`
+}
+
+// StartCertificationExpiryJob checks once at startup and then every 24 hours
+// for certifications expiring within windowDays and emits a single summary
+// notification when any are found that admins were not alerted about yet. A
+// failed check is reported through n as well. It stops when ctx is cancelled.
+func StartCertificationExpiryJob(ctx context.Context, n notifier.Notifier, windowDays int, logger *slog.Logger) {
+	run := func() {
+		err := CheckCertificationExpiry(ctx, n, windowDays, time.Now())
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		logger.Error("certification expiry job failed", "error", err)
+		failure := notifier.JobFailed("certification-expiry", "Expiring certifications could not be checked.", err)
+		if err := n.Notify(ctx, failure); err != nil {
+			logger.Error("failed to report certification expiry job failure", "error", err)
+		}
+	}
+
+	go func() {
+		run()
+
+		ticker := time.NewTicker(24 * time.Hour)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				run()
+			}
+		}
+	}()
+}
+
+func CheckCertificationExpiry(ctx context.Context, n notifier.Notifier, windowDays int, now time.Time) error {
+	certs, err := database.ExpiringCertifications(ctx, now, windowDays, windowDays)
+	if err != nil {
+		return fmt.Errorf("failed to load expiring certifications: %w", err)
+	}
+
+	certs, err = database.UnalertedCertifications(ctx, certs)
+	if err != nil {
+		return fmt.Errorf("failed to load expiry alerts: %w", err)
+	}
+	if len(certs) == 0 {
+		return nil
+	}
+
+	data := make([]expiringCertification, 0, len(certs))
+	lines := make([]string, 0, len(certs))
+	for _, cert := range certs {
+		days, _ := cert.ExpiresIn(now)
+		data = append(data, expiringCertification{
+			ID:         cert.ID.Hex(),
+			Title:      cert.Title,
+			Issuer:     cert.Issuer,
+			ExpiryDate: cert.ExpiryDate,
+			DaysLeft:   days,
+		})
+		lines = append(lines, fmt.Sprintf("%s expires in %d day(s)", cert.Title, days))
+	}
+
+	err = n.Notify(ctx, notifier.Notification{
+		Event:   "certification.expiring",
+		Subject: fmt.Sprintf("%d certification(s) expiring within %d days", len(certs), windowDays),
+		Message: strings.Join(lines, "\n"),
+		Data:    data,
+		SentAt:  now.UTC(),
+	})
+	if err != nil {
+		// Not marked, so the next run tries again
+		return err
+	}
+	if err := database.MarkCertificationsAlerted(ctx, certs, now); err != nil {
+		return fmt.Errorf("failed to record expiry alerts: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/MishraShardendu22/notifier"
+)
+
+// Notifier mails notifications to a fixed list of admins. Mails go through
+// the outbox, so Notify only fails when the mail cannot be queued.
+type Notifier struct {
+	Mailer *Mailer
+	To     []string
+}
+
+func (n Notifier) Notify(ctx context.Context, note notifier.Notification) error {
+	subject, text, html, err := Render(note)
+	if err != nil {
+		return err
+	}
+	_, err = n.Mailer.Enqueue(ctx, note.Event, n.To, subject, text, html)
+	return err
+}
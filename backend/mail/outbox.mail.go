@@ -0,0 +1,174 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type Options struct {
+	SMTP SMTP
+	// From is the sender, e.g. "Portfolio <noreply@example.com>".
+	From string
+	// MaxAttempts is how many times a mail is tried before it fails.
+	MaxAttempts int
+	// RetryBase is the delay before the first retry; it doubles with every
+	// further attempt, up to maxBackoff.
+	RetryBase time.Duration
+	// PollInterval is how often due retries are looked for.
+	PollInterval time.Duration
+	Logger       *slog.Logger
+}
+
+const (
+	maxBackoff = 6 * time.Hour
+	// lease hides a mail from other workers while it is being sent
+	lease = 2 * time.Minute
+)
+
+// Mailer sends mails from an outbox in MongoDB, so handlers never wait on
+// the SMTP server and pending retries survive restarts. Mails are claimed
+// atomically when several instances run.
+type Mailer struct {
+	opts Options
+	wake chan struct{}
+}
+
+func New(opts Options) *Mailer {
+	if opts.SMTP.TLS == "" {
+		opts.SMTP.TLS = TLSStartTLS
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 6
+	}
+	if opts.RetryBase <= 0 {
+		opts.RetryBase = time.Minute
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 10 * time.Second
+	}
+	if opts.Logger == nil {
+		opts.Logger = slog.Default()
+	}
+	return &Mailer{opts: opts, wake: make(chan struct{}, 1)}
+}
+
+// Default is nil until mail is configured.
+var Default *Mailer
+
+// Start sends queued mails until ctx is cancelled.
+func (m *Mailer) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(m.opts.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			m.drain(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-m.wake:
+			}
+		}
+	}()
+}
+
+// Enqueue stores a mail to be sent in the background.
+func (m *Mailer) Enqueue(ctx context.Context, event string, to []string, subject, text, html string) (models.MailMessage, error) {
+	msg := models.MailMessage{
+		Event:         event,
+		To:            to,
+		Subject:       subject,
+		Text:          text,
+		HTML:          html,
+		Status:        models.MailPending,
+		NextAttemptAt: time.Now(),
+	}
+	if err := mgm.Coll(&msg).CreateWithCtx(ctx, &msg); err != nil {
+		return msg, err
+	}
+
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+	return msg, nil
+}
+
+// Send delivers a mail right away, bypassing the outbox.
+func (m *Mailer) Send(ctx context.Context, to []string, subject, text, html string) error {
+	return m.opts.SMTP.Send(ctx, Message{From: m.opts.From, To: to, Subject: subject, Text: text, HTML: html})
+}
+
+// drain sends every due mail. One SMTP session at a time is plenty for
+// admin notifications.
+func (m *Mailer) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		msg, ok := m.claim(ctx)
+		if !ok {
+			return
+		}
+		m.attempt(ctx, msg)
+	}
+}
+
+// claim takes the oldest due mail and pushes its next attempt past the
+// lease, so no other worker picks it up while it is being sent.
+func (m *Mailer) claim(ctx context.Context) (models.MailMessage, bool) {
+	now := time.Now()
+	filter := bson.M{"status": models.MailPending, "next_attempt_at": bson.M{"$lte": now}}
+	update := bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"next_attempt_at": 1}).SetReturnDocument(options.After)
+
+	var msg models.MailMessage
+	err := mgm.Coll(&msg).FindOneAndUpdate(ctx, filter, update, opts).Decode(&msg)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) && ctx.Err() == nil {
+			m.opts.Logger.Error("Failed to claim mail", "error", err)
+		}
+		return msg, false
+	}
+	return msg, true
+}
+
+// attempt sends msg once and records the outcome, scheduling a retry with
+// exponential backoff until MaxAttempts is reached. Mails that fail for good
+// are only logged; mailing about them would likely fail the same way.
+func (m *Mailer) attempt(ctx context.Context, msg models.MailMessage) {
+	now := time.Now()
+	set := bson.M{"attempts": msg.Attempts + 1, "updated_at": now}
+
+	err := m.Send(ctx, msg.To, msg.Subject, msg.Text, msg.HTML)
+	switch {
+	case err == nil:
+		set["status"], set["sent_at"], set["last_error"] = models.MailSent, now, ""
+	case msg.Attempts+1 >= m.opts.MaxAttempts:
+		set["status"], set["last_error"] = models.MailFailed, err.Error()
+		m.opts.Logger.Error("Giving up on mail", "mail", msg.ID.Hex(), "event", msg.Event, "attempts", msg.Attempts+1, "error", err)
+	default:
+		set["last_error"] = err.Error()
+		set["next_attempt_at"] = now.Add(m.backoff(msg.Attempts + 1))
+		m.opts.Logger.Warn("Failed to send mail, will retry", "mail", msg.ID.Hex(), "event", msg.Event, "error", err)
+	}
+
+	if _, err := mgm.Coll(&msg).UpdateByID(ctx, msg.ID, bson.M{"$set": set}); err != nil {
+		m.opts.Logger.Error("Failed to record mail", "mail", msg.ID.Hex(), "error", err)
+	}
+}
+
+// backoff is the delay after the given number of failed attempts.
+func (m *Mailer) backoff(attempts int) time.Duration {
+	delay := m.opts.RetryBase
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
@@ -0,0 +1,101 @@
+package mail
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMessageBuild(t *testing.T) {
+	from, err := mail.ParseAddress("Portfolio <noreply@example.com>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		msg         Message
+		wantSubject string
+		wantParts   []string
+	}{
+		{"plain", Message{Subject: "Hello", Text: "hi", HTML: "<p>hi</p>"}, "Hello", []string{"text/plain; charset=utf-8", "text/html; charset=utf-8"}},
+		{"non-ASCII subject", Message{Subject: "Grüße", Text: "hi"}, "Grüße", []string{"text/plain; charset=utf-8"}},
+		// A contact's name ends up in the subject; it must not add headers
+		{"header injection", Message{Subject: "New message from x\r\nBcc: victim@example.com", Text: "hi"}, "New message from x  Bcc: victim@example.com", []string{"text/plain; charset=utf-8"}},
+		{"bare newline", Message{Subject: "a\nb", HTML: "<p>hi</p>"}, "a b", []string{"text/html; charset=utf-8"}},
+	}
+
+	for _, tt := range tests {
+		tt.msg.From, tt.msg.To = from.String(), []string{"admin@example.com"}
+		raw, err := tt.msg.build(from)
+		if err != nil {
+			t.Fatalf("%s: build: %v", tt.name, err)
+		}
+
+		parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+		if err != nil {
+			t.Fatalf("%s: ReadMessage: %v", tt.name, err)
+		}
+		if bcc := parsed.Header.Get("Bcc"); bcc != "" {
+			t.Errorf("%s: injected Bcc header %q", tt.name, bcc)
+		}
+		subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		if err != nil || subject != tt.wantSubject {
+			t.Errorf("%s: subject = %q (%v), want %q", tt.name, subject, err, tt.wantSubject)
+		}
+		if to := parsed.Header.Get("To"); to != "admin@example.com" {
+			t.Errorf("%s: To = %q", tt.name, to)
+		}
+		if id := parsed.Header.Get("Message-ID"); !strings.HasSuffix(id, "@example.com>") {
+			t.Errorf("%s: Message-ID = %q", tt.name, id)
+		}
+
+		_, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+		if err != nil {
+			t.Fatalf("%s: Content-Type: %v", tt.name, err)
+		}
+		var parts []string
+		reader := multipart.NewReader(parsed.Body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%s: NextPart: %v", tt.name, err)
+			}
+			parts = append(parts, part.Header.Get("Content-Type"))
+		}
+		if strings.Join(parts, "|") != strings.Join(tt.wantParts, "|") {
+			t.Errorf("%s: parts = %v, want %v", tt.name, parts, tt.wantParts)
+		}
+	}
+}
+
+func TestMailerBackoff(t *testing.T) {
+	m := New(Options{RetryBase: time.Minute})
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{5, 16 * time.Minute},
+		{9, 256 * time.Minute},
+		// Capped at maxBackoff
+		{10, maxBackoff},
+		{40, maxBackoff},
+	}
+
+	for _, tt := range tests {
+		if got := m.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
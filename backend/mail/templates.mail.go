@@ -0,0 +1,74 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/MishraShardendu22/notifier"
+)
+
+// Every template file is named after the notification event it renders and
+// defines three blocks: "subject", "text" and "html". default.tmpl renders
+// events without a file of their own. The data is the notifier.Notification.
+//
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+type mailTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+var templates = mustParseTemplates()
+
+func mustParseTemplates() map[string]mailTemplate {
+	files, err := fs.Glob(templateFS, "templates/*.tmpl")
+	if err != nil {
+		panic(err)
+	}
+
+	parsed := make(map[string]mailTemplate, len(files))
+	for _, file := range files {
+		src, err := templateFS.ReadFile(file)
+		if err != nil {
+			panic(err)
+		}
+		name := strings.TrimSuffix(path.Base(file), ".tmpl")
+		parsed[name] = mailTemplate{
+			text: texttemplate.Must(texttemplate.New(name).Option("missingkey=error").Parse(string(src))),
+			html: htmltemplate.Must(htmltemplate.New(name).Option("missingkey=error").Parse(string(src))),
+		}
+	}
+	return parsed
+}
+
+// Render builds the subject and bodies of the mail for n.
+func Render(n notifier.Notification) (subject, text, html string, err error) {
+	t, ok := templates[n.Event]
+	if !ok {
+		t = templates["default"]
+	}
+
+	var buf bytes.Buffer
+	if err := t.text.ExecuteTemplate(&buf, "subject", n); err != nil {
+		return "", "", "", err
+	}
+	subject = strings.TrimSpace(buf.String())
+
+	buf.Reset()
+	if err := t.text.ExecuteTemplate(&buf, "text", n); err != nil {
+		return "", "", "", err
+	}
+	text = buf.String()
+
+	buf.Reset()
+	if err := t.html.ExecuteTemplate(&buf, "html", n); err != nil {
+		return "", "", "", err
+	}
+	return subject, text, buf.String(), nil
+}
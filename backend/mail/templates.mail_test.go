@@ -0,0 +1,113 @@
+package mail
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/notifier"
+)
+
+type expiringCertification struct {
+	Title      string
+	Issuer     string
+	ExpiryDate string
+	DaysLeft   int
+}
+
+func TestRender(t *testing.T) {
+	sentAt := time.Date(2024, 3, 10, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		n           notifier.Notification
+		wantSubject string
+		wantText    []string
+		wantHTML    []string
+		wantErr     bool
+	}{
+		{
+			name: "certification.expiring",
+			n: notifier.Notification{Event: "certification.expiring", Subject: "2 certification(s) expiring within 30 days", Data: []expiringCertification{
+				{Title: "CKA", Issuer: "CNCF", ExpiryDate: "2024-03-20", DaysLeft: 10},
+				{Title: "AWS SAA", Issuer: "Amazon", ExpiryDate: "2024-04-01", DaysLeft: 22},
+			}},
+			wantSubject: "2 certification(s) expiring within 30 days",
+			wantText:    []string{"- CKA (CNCF) expires on 2024-03-20, in 10 day(s)\n- AWS SAA (Amazon) expires on 2024-04-01, in 22 day(s)"},
+			wantHTML:    []string{"<li><strong>CKA</strong> (CNCF) expires on 2024-03-20, in 10 day(s)</li>"},
+		},
+		{
+			name: "contact.received",
+			n: notifier.Notification{Event: "contact.received", Data: models.ContactMessageResponse{
+				Name: "Ana <script>", Email: "ana@example.com", Message: "Hi & hello",
+			}},
+			wantSubject: "New message from Ana <script>",
+			wantText:    []string{"Ana <script> <ana@example.com> wrote through the contact form:\n\nHi & hello\n"},
+			// User input is escaped in the HTML part
+			wantHTML: []string{"<strong>Ana &lt;script&gt;</strong>", `href="mailto:ana@example.com"`, "Hi &amp; hello"},
+		},
+		{
+			name:        "job.failed",
+			n:           notifier.JobFailed("certification-expiry", "Expiring certifications could not be checked.", errors.New("connection refused")),
+			wantSubject: "Background job failed: certification-expiry",
+			wantText:    []string{"Expiring certifications could not be checked.", "Error: connection refused"},
+			wantHTML:    []string{"<strong>certification-expiry</strong>", "<pre>connection refused</pre>"},
+		},
+		{
+			name:        "unknown event uses default",
+			n:           notifier.Notification{Event: "something.else", Subject: "Heads up", Message: "1 < 2", SentAt: sentAt},
+			wantSubject: "Heads up",
+			wantText:    []string{"1 < 2\n"},
+			wantHTML:    []string{"<p>1 &lt; 2</p>"},
+		},
+		{
+			name:    "missing data",
+			n:       notifier.Notification{Event: "contact.received"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		subject, text, html, err := Render(tt.n)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: Render succeeded, want an error", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Render: %v", tt.name, err)
+			continue
+		}
+
+		if subject != tt.wantSubject {
+			t.Errorf("%s: subject = %q, want %q", tt.name, subject, tt.wantSubject)
+		}
+		for _, want := range tt.wantText {
+			if !strings.Contains(text, want) {
+				t.Errorf("%s: text %q does not contain %q", tt.name, text, want)
+			}
+		}
+		for _, want := range tt.wantHTML {
+			if !strings.Contains(html, want) {
+				t.Errorf("%s: html %q does not contain %q", tt.name, html, want)
+			}
+		}
+	}
+}
+
+// Every template has to define all three blocks.
+func TestTemplatesComplete(t *testing.T) {
+	for name, tmpl := range templates {
+		for _, block := range []string{"subject", "text", "html"} {
+			if tmpl.text.Lookup(block) == nil || tmpl.html.Lookup(block) == nil {
+				t.Errorf("template %s does not define %q", name, block)
+			}
+		}
+	}
+	if _, ok := templates["default"]; !ok {
+		t.Error("no default template")
+	}
+}
@@ -2,22 +2,41 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"log"
 	"log/slog"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/MishraShardendu22/analytics"
+	"github.com/MishraShardendu22/cache"
+	"github.com/MishraShardendu22/controller"
 	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/events"
+	"github.com/MishraShardendu22/graph"
+	"github.com/MishraShardendu22/jobs"
+	"github.com/MishraShardendu22/mail"
+	"github.com/MishraShardendu22/middleware"
+	"github.com/MishraShardendu22/migrations"
 	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/notifier"
+	"github.com/MishraShardendu22/openapi"
 	"github.com/MishraShardendu22/route"
+	"github.com/MishraShardendu22/rpc"
+	"github.com/MishraShardendu22/stream"
 	"github.com/MishraShardendu22/util"
+	"github.com/MishraShardendu22/webhooks"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
 	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
 )
 
 func loadConfig() *models.Config {
@@ -30,10 +49,61 @@ func loadConfig() *models.Config {
 		DbName:           util.GetEnv("DB_NAME", "test"),
 		AdminPass:        util.GetEnv("ADMIN_PASS", ""),
 		JWT_SECRET:       util.GetEnv("JWT_SECRET", ""),
+
+		CertExpiryWindowDays:  util.GetEnvInt("CERT_EXPIRY_WINDOW_DAYS", 30),
+		ExpiryNotifier:        util.GetEnv("EXPIRY_NOTIFIER", "log"),
+		ExpiryNotifyURL:       util.GetEnv("EXPIRY_NOTIFY_URL", ""),
+		DeletePolicy:          util.GetEnv("DELETE_POLICY", string(database.DeleteCascade)),
+		MigrateOnStart:        util.GetEnv("MIGRATE_ON_START", "true") == "true",
+		CacheControl:          util.GetEnv("CACHE_CONTROL", controller.PublicCacheControl),
+		ReadCacheTTL:          time.Duration(util.GetEnvInt("READ_CACHE_TTL_SECONDS", 600)) * time.Second,
+		ReadCacheMaxEntries:   util.GetEnvInt("READ_CACHE_MAX_ENTRIES", 1000),
+		LegacyAPIDeprecatedAt: util.GetEnv("LEGACY_API_DEPRECATED_AT", "2026-10-19"),
+		LegacyAPISunset:       util.GetEnv("LEGACY_API_SUNSET", "2027-04-30"),
+		GraphQLMaxDepth:       util.GetEnvInt("GRAPHQL_MAX_DEPTH", 6),
+		GraphQLMaxComplexity:  util.GetEnvInt("GRAPHQL_MAX_COMPLEXITY", 1000),
+		GRPCPort:              util.GetEnv("GRPC_PORT", ""),
+		WebhookMaxAttempts:    util.GetEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		WebhookRetryBase:      time.Duration(util.GetEnvInt("WEBHOOK_RETRY_BASE_SECONDS", 30)) * time.Second,
+		ViewAnalytics:         util.GetEnv("VIEW_ANALYTICS", "true") == "true",
+		ProxyHeader:           util.GetEnv("PROXY_HEADER", ""),
+		SMTPHost:              util.GetEnv("SMTP_HOST", ""),
+		SMTPPort:              util.GetEnvInt("SMTP_PORT", 587),
+		SMTPUsername:          util.GetEnv("SMTP_USERNAME", ""),
+		SMTPPassword:          util.GetEnv("SMTP_PASSWORD", ""),
+		SMTPTLS:               util.GetEnv("SMTP_TLS", mail.TLSStartTLS),
+		MailFrom:              util.GetEnv("MAIL_FROM", ""),
+		MailAdminTo:           util.GetEnvList("MAIL_ADMIN_TO"),
+		MailMaxAttempts:       util.GetEnvInt("MAIL_MAX_ATTEMPTS", 6),
+		MailRetryBase:         time.Duration(util.GetEnvInt("MAIL_RETRY_BASE_SECONDS", 60)) * time.Second,
 	}
 	return config
 }
 
+// newMailer returns nil when email is not configured.
+func newMailer(config *models.Config, logger *slog.Logger) *mail.Mailer {
+	if config.SMTPHost == "" {
+		return nil
+	}
+	if config.MailFrom == "" {
+		logger.Warn("SMTP_HOST is set without MAIL_FROM, email is disabled")
+		return nil
+	}
+	return mail.New(mail.Options{
+		SMTP: mail.SMTP{
+			Host:     config.SMTPHost,
+			Port:     config.SMTPPort,
+			Username: config.SMTPUsername,
+			Password: config.SMTPPassword,
+			TLS:      config.SMTPTLS,
+		},
+		From:        config.MailFrom,
+		MaxAttempts: config.MailMaxAttempts,
+		RetryBase:   config.MailRetryBase,
+		Logger:      logger,
+	})
+}
+
 func setupLogger(config *models.Config) {
 	var level slog.Level
 	switch config.LogLevel {
@@ -60,6 +130,8 @@ func setupLogger(config *models.Config) {
 }
 
 func setupMiddleware(app *fiber.App, config *models.Config) {
+	app.Use(requestid.New())
+
 	app.Use(recover.New(recover.Config{
 		EnableStackTrace: config.Environment == "development",
 	}))
@@ -67,24 +139,25 @@ func setupMiddleware(app *fiber.App, config *models.Config) {
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:  config.CorsAllowOrigins,
 		AllowMethods:  "GET,POST,PUT,PATCH,DELETE,OPTIONS",
-		AllowHeaders:  "Origin, Content-Type, Accept, Authorization",
-		ExposeHeaders: "Content-Length",
+		AllowHeaders:  "Origin, Content-Type, Accept, Authorization, If-Match, If-None-Match, If-Modified-Since, X-Request-ID, X-Referrer",
+		ExposeHeaders: "Content-Length, ETag, X-Request-ID, Deprecation, Sunset, Link",
 		MaxAge:        86400,
 	}))
 
 	app.Use(logger.New(logger.Config{
-		Format:     "[${time}] ${status} - ${latency} ${method} ${path}\n",
+		Format:     "[${time}] ${status} - ${latency} ${method} ${path} ${respHeader:X-Request-ID}\n",
 		TimeFormat: "2006-01-02 15:04:05",
 		TimeZone:   "Local",
 	}))
 }
 
-func gracefulShutdown(app *fiber.App, logger *slog.Logger) {
+func gracefulShutdown(app *fiber.App, grpcServer *grpc.Server, logger *slog.Logger) {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
 	<-quit
 	logger.Info("Shutting down server...")
+	stream.Shutdown()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -93,6 +166,20 @@ func gracefulShutdown(app *fiber.App, logger *slog.Logger) {
 		logger.Error("Server forced to shutdown", "error", err)
 	}
 
+	if grpcServer != nil {
+		// Watch streams never finish on their own, so stop them after the deadline
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			grpcServer.Stop()
+		}
+	}
+
 	logger.Info("Server exited")
 }
 
@@ -107,14 +194,54 @@ func init() {
 }
 
 func main() {
+	integrity := flag.String("integrity", "", "run the data integrity `mode` (check or repair) and exit")
+	dryRun := flag.Bool("dry-run", false, "with -integrity=repair, report what would change without writing")
+	migrate := flag.String("migrate", "", "run database migrations `action` (up, down or status) and exit")
+	migrateSteps := flag.Int("migrate-steps", 1, "number of migrations -migrate=down reverts")
+	apiSpec := flag.String("openapi", "", "`action` on the OpenAPI document (check or print) and exit")
+	mailTest := flag.String("mail-test", "", "send a test email to `address` through the configured SMTP server and exit")
+	flag.Parse()
+
+	if *apiSpec != "" {
+		os.Exit(runOpenAPICommand(*apiSpec))
+	}
+
 	config := loadConfig()
+	if *mailTest != "" {
+		os.Exit(runMailTestCommand(newMailer(config, slog.Default()), *mailTest))
+	}
+
 	if err := database.ConnectDatabase(config.DbName, config.MONGODB_URI); err != nil {
 		log.Fatalf("Database connection failed: %v", err)
 	}
 
+	if *integrity != "" {
+		os.Exit(runIntegrityCommand(*integrity, *dryRun))
+	}
+
 	setupLogger(config)
 	logger := slog.Default()
 
+	if *migrate != "" {
+		os.Exit(runMigrateCommand(*migrate, *migrateSteps, logger))
+	}
+
+	if config.MigrateOnStart {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		err := migrations.Up(ctx, logger)
+		cancel()
+		if err != nil {
+			log.Fatalf("Database migration failed: %v", err)
+		}
+	}
+
+	indexCtx, cancelIndexes := context.WithTimeout(context.Background(), 2*time.Minute)
+	if err := database.EnsureIndexes(indexCtx, logger); err != nil {
+		logger.Error("Some indexes could not be created", "error", err)
+	}
+	cancelIndexes()
+	database.SupportsTransactions()
+
 	logger.Info("Starting Portfolio Backend",
 		"environment", config.Environment,
 		"port", config.Port,
@@ -127,28 +254,84 @@ func main() {
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
 		IdleTimeout:  120 * time.Second,
+		ProxyHeader:  config.ProxyHeader,
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			logger.Error("request error", slog.Group("req",
+				slog.String("request_id", util.RequestID(c)),
 				slog.String("method", c.Method()),
 				slog.String("path", c.Path()),
 				slog.String("error", err.Error()),
 			))
 
 			code := fiber.StatusInternalServerError
-			if e, ok := err.(*fiber.Error); ok {
-				code = e.Code
+			message := "Internal Server Error"
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				code = fiberErr.Code
+				message = fiberErr.Message
+			} else if config.Environment == "development" {
+				message = err.Error()
 			}
 
-			return c.Status(code).JSON(fiber.Map{
-				"error":   "Internal Server Error",
-				"message": err.Error(),
-			})
+			errCode := util.CodeForStatus(code)
+			if code == fiber.StatusNotFound {
+				errCode = util.CodeRouteNotFound
+			}
+			return util.ErrorAPI(c, code, errCode, message, nil)
 		},
 	})
 
+	controller.CertificationExpiryWindowDays = config.CertExpiryWindowDays
+	controller.PublicCacheControl = config.CacheControl
+	cache.Default = cache.New(config.ReadCacheTTL, config.ReadCacheMaxEntries)
+	if policy, ok := database.ParseDeletePolicy(config.DeletePolicy); ok {
+		controller.DeletePolicy = policy
+	} else {
+		logger.Warn("Unknown DELETE_POLICY, using cascade", "delete_policy", config.DeletePolicy)
+	}
+
 	setupMiddleware(app, config)
 
-	SetUpRoutes(app, logger)
+	if err := SetUpRoutes(app, logger); err != nil {
+		logger.Error("Failed to set up routes", "error", err)
+		os.Exit(1)
+	}
+	if missing := openapi.Undocumented(app.GetRoutes(true), apiV1Prefix); len(missing) > 0 {
+		logger.Warn("Routes missing from the OpenAPI document", "routes", missing)
+	}
+
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	expiryNotifier := notifier.New(config.ExpiryNotifier, config.ExpiryNotifyURL, logger)
+	var failureNotifier notifier.Notifier
+	if mail.Default = newMailer(config, logger); mail.Default != nil {
+		if len(config.MailAdminTo) == 0 {
+			logger.Warn("MAIL_ADMIN_TO is empty, no admin emails will be sent")
+		} else {
+			mailNotifier := mail.Notifier{Mailer: mail.Default, To: config.MailAdminTo}
+			expiryNotifier = notifier.Multi{expiryNotifier, mailNotifier}
+			failureNotifier = mailNotifier
+			controller.ContactNotifier = mailNotifier
+		}
+		mail.Default.Start(jobCtx)
+	}
+	jobs.StartCertificationExpiryJob(jobCtx, expiryNotifier, config.CertExpiryWindowDays, logger)
+
+	analytics.Default = analytics.New(analytics.Options{
+		Disabled: !config.ViewAnalytics,
+		OwnHosts: analytics.OwnHosts(config.CorsAllowOrigins),
+		Logger:   logger,
+	})
+	analytics.Default.Start(jobCtx)
+
+	webhooks.Default = webhooks.New(webhooks.Options{
+		MaxAttempts: config.WebhookMaxAttempts,
+		RetryBase:   config.WebhookRetryBase,
+		Logger:      logger,
+		Notifier:    failureNotifier,
+	})
+	webhooks.Default.Start(jobCtx, events.Default)
 
 	go func() {
 		logger.Info("Server starting", "port", config.Port)
@@ -158,29 +341,97 @@ func main() {
 		}
 	}()
 
-	gracefulShutdown(app, logger)
+	var grpcServer *grpc.Server
+	if config.GRPCPort != "" {
+		lis, err := net.Listen("tcp", ":"+config.GRPCPort)
+		if err != nil {
+			logger.Error("gRPC server failed to listen", "port", config.GRPCPort, "error", err)
+			os.Exit(1)
+		}
+		grpcServer = rpc.NewServer(rpc.Options{ExpiryWindowDays: config.CertExpiryWindowDays, Logger: logger})
+		go func() {
+			logger.Info("gRPC server starting", "port", config.GRPCPort)
+			if err := grpcServer.Serve(lis); err != nil {
+				logger.Error("gRPC server stopped", "error", err)
+			}
+		}()
+	}
+
+	gracefulShutdown(app, grpcServer, logger)
 }
 
-func SetUpRoutes(app *fiber.App, logger *slog.Logger) {
+const apiV1Prefix = "/api/v1"
+
+func SetUpRoutes(app *fiber.App, logger *slog.Logger) error {
 	config := loadConfig()
 
-	route.SetupExpRoutes(app, config.JWT_SECRET)
-	route.SetupSkillRoutes(app, config.JWT_SECRET)
-	route.SetupProjectRoutes(app, config.JWT_SECRET)
-	route.SetupCertificationRoutes(app, config.JWT_SECRET)
-	route.SetupAdminRoutes(app, config.AdminPass, config.JWT_SECRET)
+	if err := setUpV1Routes(app.Group(apiV1Prefix), config); err != nil {
+		return err
+	}
+	route.SetupRedirectRoutes(app)
+
+	app.Get("/api/openapi.json", openapi.Handler(app, apiV1Prefix, "Portfolio Backend", "1.0.0"))
+	app.Get("/api/docs/*", openapi.Explorer("/api/openapi.json"))
+
+	// Unversioned paths keep serving v1 until the sunset date
+	deprecatedAt, ok := util.ParseDate(config.LegacyAPIDeprecatedAt)
+	if !ok {
+		logger.Warn("Invalid LEGACY_API_DEPRECATED_AT, using today", "value", config.LegacyAPIDeprecatedAt)
+		deprecatedAt = time.Now().UTC()
+	}
+	sunset, ok := util.ParseDate(config.LegacyAPISunset)
+	if !ok && config.LegacyAPISunset != "" {
+		logger.Warn("Invalid LEGACY_API_SUNSET, omitting the Sunset header", "value", config.LegacyAPISunset)
+	}
+	return setUpV1Routes(app.Group("/api", middleware.Deprecated("/api", apiV1Prefix, deprecatedAt, sunset)), config)
+}
+
+func setUpV1Routes(router fiber.Router, config *models.Config) error {
+	route.SetupV1(router, config.AdminPass, config.JWT_SECRET)
+	err := route.SetupGraphRoutes(router, graph.Options{
+		MaxDepth:         config.GraphQLMaxDepth,
+		MaxComplexity:    config.GraphQLMaxComplexity,
+		ExpiryWindowDays: config.CertExpiryWindowDays,
+	})
+	if err != nil {
+		return err
+	}
+	route.SetupStreamRoutes(router, stream.Options{Bus: events.Default})
 
-	app.Get("/api/test123", func(c *fiber.Ctx) error {
+	router.Get("/test123", func(c *fiber.Ctx) error {
 		return c.JSON(fiber.Map{
 			"message": "Working fine",
 		})
 	})
 
-	app.Get("/api/leetcode", FetchLeetCodeData)
-	app.Get("/api/github", FetchGitHubProfile)
-	app.Get("/api/github/commits", FetchGitHubCommits)
-	app.Get("/api/github/languages", FetchGitHubLanguages)
-	app.Get("/api/github/stars", FetchGitHubStars)
-	app.Get("/api/github/top-repos", FetchTopStarredRepos)
-	app.Get("/api/github/calendar", FetchContributionCalendar)
+	// Stats are cached for the read cache TTL; every refresh is published
+	stats := func(name string, handler fiber.Handler) []fiber.Handler {
+		return []fiber.Handler{middleware.ReadCache(cache.TagStats), middleware.StatsRefreshed(name), handler}
+	}
+	router.Get("/leetcode", stats("leetcode", FetchLeetCodeData)...)
+	router.Get("/github", stats("github", FetchGitHubProfile)...)
+	router.Get("/github/commits", stats("github/commits", FetchGitHubCommits)...)
+	router.Get("/github/languages", stats("github/languages", FetchGitHubLanguages)...)
+	router.Get("/github/stars", stats("github/stars", FetchGitHubStars)...)
+	router.Get("/github/top-repos", stats("github/top-repos", FetchTopStarredRepos)...)
+	router.Get("/github/calendar", stats("github/calendar", FetchContributionCalendar)...)
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/test123", Tag: "Stats", Summary: "Liveness check", Raw: true},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/leetcode", Tag: "Stats", Summary: "LeetCode profile and solved counts",
+			Raw: true, Errors: []int{fiber.StatusBadGateway}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/github", Tag: "Stats", Summary: "GitHub profile",
+			Raw: true, Errors: []int{fiber.StatusBadGateway}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/github/commits", Tag: "Stats", Summary: "Commits per day across repositories",
+			Raw: true, Errors: []int{fiber.StatusBadGateway}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/github/languages", Tag: "Stats", Summary: "Bytes of code per language across repositories",
+			Raw: true, Response: map[string]int{}, Errors: []int{fiber.StatusBadGateway}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/github/stars", Tag: "Stats", Summary: "Total stars",
+			Raw: true, Response: map[string]int{}, Errors: []int{fiber.StatusBadGateway}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/github/top-repos", Tag: "Stats", Summary: "Most starred repositories",
+			Raw: true, Response: []map[string]any{}, Errors: []int{fiber.StatusBadGateway}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/github/calendar", Tag: "Stats", Summary: "Contribution calendar",
+			Raw: true, Errors: []int{fiber.StatusBadGateway}},
+	)
+	return nil
 }
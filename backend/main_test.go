@@ -0,0 +1,21 @@
+package main
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/MishraShardendu22/openapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// TestRoutesDocumented fails when a route is added without an OpenAPI entry.
+func TestRoutesDocumented(t *testing.T) {
+	app := fiber.New()
+	if err := SetUpRoutes(app, slog.Default()); err != nil {
+		t.Fatal(err)
+	}
+
+	if missing := openapi.Undocumented(app.GetRoutes(true), apiV1Prefix); len(missing) > 0 {
+		t.Errorf("routes missing from the OpenAPI document: %v", missing)
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/MishraShardendu22/analytics"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ReferrerHeader carries the page's own referrer (document.referrer). A
+// frontend calling the API from the browser sends it, since the Referer it
+// gets is the frontend page itself.
+const ReferrerHeader = "X-Referrer"
+
+// TrackView counts a view of the entity named by the `id` route parameter
+// once the handler after it has answered 200 or 304. It goes before
+// ReadCache so that cached responses count too. Prefetches are skipped.
+func TrackView(entity string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		status := c.Response().StatusCode()
+		if c.Method() != fiber.MethodGet || (status != fiber.StatusOK && status != fiber.StatusNotModified) {
+			return nil
+		}
+		if c.Get("Sec-Purpose") != "" || c.Get("Purpose") == "prefetch" {
+			return nil
+		}
+		id, err := primitive.ObjectIDFromHex(c.Params("id"))
+		if err != nil {
+			return nil
+		}
+
+		referrer := c.Get(ReferrerHeader)
+		if referrer == "" {
+			referrer = c.Get(fiber.HeaderReferer)
+		}
+		// Header values point into the request buffer, which is reused
+		analytics.Default.Track(analytics.View{
+			Entity:    entity,
+			EntityID:  id,
+			IP:        utils.CopyString(c.IP()),
+			UserAgent: utils.CopyString(c.Get(fiber.HeaderUserAgent)),
+			Referrer:  utils.CopyString(referrer),
+			At:        time.Now(),
+		})
+		return nil
+	}
+}
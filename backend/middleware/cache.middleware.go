@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/MishraShardendu22/cache"
+	"github.com/MishraShardendu22/events"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ReadCache serves GET requests from cache.Default, keyed by path and sorted
+// query string. Successful responses are stored under tags so that
+// InvalidateCache on the matching write routes can drop them, and are dropped
+// early if their Expires header comes before the TTL ends.
+func ReadCache(tags ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		store := cache.Default
+		if !store.Enabled() || (c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead) {
+			return c.Next()
+		}
+
+		key := cacheKey(c)
+		if entry, ok := store.Get(key); ok {
+			c.Set("X-Cache", "HIT")
+			if entry.ETag != "" {
+				c.Set(fiber.HeaderETag, entry.ETag)
+			}
+			if !entry.LastModified.IsZero() {
+				c.Set(fiber.HeaderLastModified, entry.LastModified.UTC().Format(http.TimeFormat))
+			}
+			if entry.CacheControl != "" {
+				c.Set(fiber.HeaderCacheControl, entry.CacheControl)
+			}
+
+			if util.NotModified(c, entry.ETag, entry.LastModified) {
+				return c.SendStatus(fiber.StatusNotModified)
+			}
+
+			c.Set(fiber.HeaderContentType, entry.ContentType)
+			return c.Status(entry.Status).Send(entry.Body)
+		}
+
+		c.Set("X-Cache", "MISS")
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		resp := c.Response()
+		if c.Method() != fiber.MethodGet || resp.StatusCode() != fiber.StatusOK {
+			return nil
+		}
+
+		entry := cache.Entry{
+			Status:       resp.StatusCode(),
+			Body:         append([]byte(nil), resp.Body()...),
+			ContentType:  string(resp.Header.ContentType()),
+			ETag:         string(resp.Header.Peek(fiber.HeaderETag)),
+			CacheControl: string(resp.Header.Peek(fiber.HeaderCacheControl)),
+		}
+		if lm, err := http.ParseTime(string(resp.Header.Peek(fiber.HeaderLastModified))); err == nil {
+			entry.LastModified = lm
+		}
+		if expires, err := http.ParseTime(string(resp.Header.Peek(fiber.HeaderExpires))); err == nil {
+			entry.Expires = expires
+		}
+		store.Set(key, entry, tags...)
+		return nil
+	}
+}
+
+// InvalidateCache drops the cached responses tagged with tags once the write
+// handler after it has succeeded.
+func InvalidateCache(tags ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		if c.Response().StatusCode() < fiber.StatusBadRequest {
+			cache.Default.Invalidate(tags...)
+		}
+		return nil
+	}
+}
+
+// StatsRefreshed publishes a stats.refreshed event for name with the new
+// response body. It goes after ReadCache, so it only runs on a miss - when
+// the stats are fetched again because the cached copy expired.
+func StatsRefreshed(name string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		if cache.Default.Enabled() && c.Method() == fiber.MethodGet && c.Response().StatusCode() == fiber.StatusOK {
+			body := append([]byte(nil), c.Response().Body()...)
+			if json.Valid(body) {
+				events.Publish(events.Stats, events.Refreshed, name, json.RawMessage(body))
+			}
+		}
+		return nil
+	}
+}
+
+func cacheKey(c *fiber.Ctx) string {
+	var params []string
+	c.Request().URI().QueryArgs().VisitAll(func(key, value []byte) {
+		params = append(params, string(key)+"="+string(value))
+	})
+	sort.Strings(params)
+	return c.Path() + "?" + strings.Join(params, "&")
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Deprecated marks every response under its group as deprecated (RFC 9745)
+// with the date it stops working (RFC 8594), and links to the same path
+// under successor. Requests already addressed to successor pass through
+// untouched, since a group mounted at /api also sees /api/v1 traffic.
+func Deprecated(prefix, successor string, deprecatedAt, sunset time.Time) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		path := c.Path()
+		if path == successor || strings.HasPrefix(path, successor+"/") {
+			return c.Next()
+		}
+
+		c.Set("Deprecation", "@"+strconv.FormatInt(deprecatedAt.Unix(), 10))
+		if !sunset.IsZero() {
+			c.Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+		}
+		c.Append(fiber.HeaderLink, `<`+successor+strings.TrimPrefix(path, prefix)+`>; rel="successor-version"`)
+		return c.Next()
+	}
+}
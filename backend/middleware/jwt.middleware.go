@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"errors"
 	"strings"
 
+	"github.com/MishraShardendu22/util"
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt"
 )
@@ -11,16 +13,12 @@ func JWTMiddleware(secret string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Authorization header is required",
-			})
+			return util.ErrorAPI(c, fiber.StatusUnauthorized, util.CodeTokenMissing, "Authorization header is required", nil)
 		}
 
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 		if tokenString == authHeader {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Bearer token is required",
-			})
+			return util.ErrorAPI(c, fiber.StatusUnauthorized, util.CodeTokenMissing, "Bearer token is required", nil)
 		}
 
 		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
@@ -31,22 +29,20 @@ func JWTMiddleware(secret string) fiber.Handler {
 		})
 
 		if err != nil {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid token: " + err.Error(),
-			})
+			var validationErr *jwt.ValidationError
+			if errors.As(err, &validationErr) && validationErr.Errors&jwt.ValidationErrorExpired != 0 {
+				return util.ErrorAPI(c, fiber.StatusUnauthorized, util.CodeTokenExpired, "Token has expired", nil)
+			}
+			return util.ErrorAPI(c, fiber.StatusUnauthorized, util.CodeTokenInvalid, "Invalid token: "+err.Error(), nil)
 		}
 
 		if !token.Valid {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid token",
-			})
+			return util.ErrorAPI(c, fiber.StatusUnauthorized, util.CodeTokenInvalid, "Invalid token", nil)
 		}
 
 		claims, ok := token.Claims.(jwt.MapClaims)
 		if !ok {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid token claims",
-			})
+			return util.ErrorAPI(c, fiber.StatusUnauthorized, util.CodeTokenInvalid, "Invalid token claims", nil)
 		}
 
 		c.Locals("user_id", claims["id"])
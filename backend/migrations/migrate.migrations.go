@@ -0,0 +1,274 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	collectionName = "migrations"
+	lockCollection = "migration_locks"
+	lockID         = "migrations"
+
+	// A crashed instance cannot hold the lock for longer than lockTTL; a
+	// running one keeps extending it every lockRefresh.
+	lockTTL     = 2 * time.Minute
+	lockRefresh = 30 * time.Second
+	lockPoll    = 2 * time.Second
+)
+
+type Func func(ctx context.Context, db *mongo.Database) error
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      Func
+	// Down reverts Up. Migrations without Down cannot be rolled back.
+	Down Func
+}
+
+type record struct {
+	Version   int       `bson:"_id" json:"version"`
+	Name      string    `bson:"name" json:"name"`
+	AppliedAt time.Time `bson:"applied_at" json:"applied_at"`
+}
+
+type Status struct {
+	Version   int        `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+var registry = map[int]Migration{}
+
+// Register adds a migration. It is called from init in each migration file
+// and panics on a duplicate or non-positive version.
+func Register(m Migration) {
+	if m.Version <= 0 {
+		panic(fmt.Sprintf("migration %q has invalid version %d", m.Name, m.Version))
+	}
+	if existing, ok := registry[m.Version]; ok {
+		panic(fmt.Sprintf("migration version %d registered twice (%q and %q)", m.Version, existing.Name, m.Name))
+	}
+	registry[m.Version] = m
+}
+
+func sorted() []Migration {
+	list := make([]Migration, 0, len(registry))
+	for _, m := range registry {
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list
+}
+
+func database() (*mongo.Database, error) {
+	_, _, db, err := mgm.DefaultConfigs()
+	return db, err
+}
+
+func applied(ctx context.Context, db *mongo.Database) (map[int]record, error) {
+	cursor, err := db.Collection(collectionName).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	var records []record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	done := make(map[int]record, len(records))
+	for _, r := range records {
+		done[r.Version] = r
+	}
+	return done, nil
+}
+
+// Up applies every pending migration in version order while holding the
+// migration lock. Instances that start concurrently wait for the lock and
+// then find nothing left to do.
+func Up(ctx context.Context, logger *slog.Logger) error {
+	db, err := database()
+	if err != nil {
+		return err
+	}
+
+	return withLock(ctx, db, logger, func(ctx context.Context) error {
+		done, err := applied(ctx, db)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range sorted() {
+			if _, ok := done[m.Version]; ok {
+				continue
+			}
+
+			logger.Info("Applying migration", "version", m.Version, "name", m.Name)
+			if err := m.Up(ctx, db); err != nil {
+				return fmt.Errorf("migration %d (%s) failed: %w", m.Version, m.Name, err)
+			}
+
+			rec := record{Version: m.Version, Name: m.Name, AppliedAt: time.Now().UTC()}
+			if _, err := db.Collection(collectionName).InsertOne(ctx, rec); err != nil {
+				return fmt.Errorf("failed to record migration %d: %w", m.Version, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Down reverts the most recent steps applied migrations, newest first.
+func Down(ctx context.Context, steps int, logger *slog.Logger) error {
+	db, err := database()
+	if err != nil {
+		return err
+	}
+
+	return withLock(ctx, db, logger, func(ctx context.Context) error {
+		done, err := applied(ctx, db)
+		if err != nil {
+			return err
+		}
+
+		versions := make([]int, 0, len(done))
+		for v := range done {
+			versions = append(versions, v)
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+		for i, v := range versions {
+			if i >= steps {
+				break
+			}
+
+			m, ok := registry[v]
+			if !ok {
+				return fmt.Errorf("migration %d is applied but not known to this build", v)
+			}
+			if m.Down == nil {
+				return fmt.Errorf("migration %d (%s) cannot be rolled back", m.Version, m.Name)
+			}
+
+			logger.Info("Reverting migration", "version", m.Version, "name", m.Name)
+			if err := m.Down(ctx, db); err != nil {
+				return fmt.Errorf("reverting migration %d (%s) failed: %w", m.Version, m.Name, err)
+			}
+
+			if _, err := db.Collection(collectionName).DeleteOne(ctx, bson.M{"_id": v}); err != nil {
+				return fmt.Errorf("failed to unrecord migration %d: %w", v, err)
+			}
+		}
+		return nil
+	})
+}
+
+// List reports every known migration and whether it has been applied.
+func List(ctx context.Context) ([]Status, error) {
+	db, err := database()
+	if err != nil {
+		return nil, err
+	}
+
+	done, err := applied(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(registry))
+	for _, m := range sorted() {
+		s := Status{Version: m.Version, Name: m.Name}
+		if r, ok := done[m.Version]; ok {
+			s.Applied = true
+			s.AppliedAt = &r.AppliedAt
+		}
+		statuses = append(statuses, s)
+	}
+	return statuses, nil
+}
+
+// withLock runs fn while holding the cluster-wide migration lock, waiting for
+// it until ctx is done.
+func withLock(ctx context.Context, db *mongo.Database, logger *slog.Logger, fn func(ctx context.Context) error) error {
+	locks := db.Collection(lockCollection)
+
+	host, _ := os.Hostname()
+	owner := host + "/" + primitive.NewObjectID().Hex()
+
+	for {
+		acquired, err := acquireLock(ctx, locks, owner)
+		if err != nil {
+			return err
+		}
+		if acquired {
+			break
+		}
+
+		logger.Info("Waiting for migration lock held by another instance")
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for migration lock: %w", ctx.Err())
+		case <-time.After(lockPoll):
+		}
+	}
+
+	refreshCtx, stopRefresh := context.WithCancel(ctx)
+	defer stopRefresh()
+	go func() {
+		ticker := time.NewTicker(lockRefresh)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-refreshCtx.Done():
+				return
+			case <-ticker.C:
+				update := bson.M{"$set": bson.M{"expires_at": time.Now().Add(lockTTL)}}
+				if _, err := locks.UpdateOne(refreshCtx, bson.M{"_id": lockID, "owner": owner}, update); err != nil {
+					logger.Warn("Failed to extend migration lock", "error", err)
+				}
+			}
+		}
+	}()
+
+	defer func() {
+		if _, err := locks.DeleteOne(context.Background(), bson.M{"_id": lockID, "owner": owner}); err != nil {
+			logger.Warn("Failed to release migration lock", "error", err)
+		}
+	}()
+
+	return fn(ctx)
+}
+
+func acquireLock(ctx context.Context, locks *mongo.Collection, owner string) (bool, error) {
+	now := time.Now()
+	lock := bson.M{"_id": lockID, "owner": owner, "locked_at": now, "expires_at": now.Add(lockTTL)}
+
+	_, err := locks.InsertOne(ctx, lock)
+	if err == nil {
+		return true, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return false, err
+	}
+
+	// Take over a lock whose holder stopped refreshing it
+	filter := bson.M{"_id": lockID, "expires_at": bson.M{"$lt": now}}
+	res := locks.FindOneAndReplace(ctx, filter, lock, options.FindOneAndReplace().SetReturnDocument(options.After))
+	if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+		return false, nil
+	}
+	return res.Err() == nil, res.Err()
+}
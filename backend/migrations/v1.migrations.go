@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Documents created with nil slices were stored with null arrays, which
+// $addToSet and $push refuse to modify. Replace them with empty arrays.
+func init() {
+	arrays := map[string][]string{
+		mgm.CollName(&models.User{}):                        {"skills", "projects", "experiences", "certifications"},
+		mgm.CollName(&models.Project{}):                     {"skills"},
+		mgm.CollName(&models.Experience{}):                  {"technologies", "projects", "images"},
+		mgm.CollName(&models.CertificationOrAchievements{}): {"projects", "skills", "images"},
+	}
+
+	Register(Migration{
+		Version: 1,
+		Name:    "normalize_null_arrays",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			for coll, fields := range arrays {
+				for _, field := range fields {
+					if _, err := db.Collection(coll).UpdateMany(ctx, bson.M{field: nil}, bson.M{"$set": bson.M{field: bson.A{}}}); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+		// Empty arrays read back exactly like null ones, nothing to revert
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return nil
+		},
+	})
+}
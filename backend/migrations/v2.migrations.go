@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Content documents carry a version counter for optimistic concurrency.
+// Existing documents start at version 1.
+func init() {
+	collections := []string{
+		mgm.CollName(&models.Project{}),
+		mgm.CollName(&models.Experience{}),
+		mgm.CollName(&models.CertificationOrAchievements{}),
+	}
+
+	Register(Migration{
+		Version: 2,
+		Name:    "add_document_versions",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			for _, coll := range collections {
+				filter := bson.M{"version": bson.M{"$exists": false}}
+				if _, err := db.Collection(coll).UpdateMany(ctx, filter, bson.M{"$set": bson.M{"version": 1}}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			for _, coll := range collections {
+				if _, err := db.Collection(coll).UpdateMany(ctx, bson.M{}, bson.M{"$unset": bson.M{"version": ""}}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Content types whose detail views are counted.
+const (
+	ViewProject       = "project"
+	ViewExperience    = "experience"
+	ViewCertification = "certification"
+)
+
+// OutboundLinks lists, per content type, the JSON names of the fields whose
+// URL the click redirect may send visitors to.
+var OutboundLinks = map[string][]string{
+	ViewProject:       {"project_live_link", "project_repository", "project_video"},
+	ViewExperience:    {"certificate_url"},
+	ViewCertification: {"certificate_url"},
+}
+
+// ViewSalt is the random salt visitor hashes are made with on one UTC day
+// (YYYY-MM-DD). It expires shortly after the day ends, after which that
+// day's hashes can no longer be linked to an IP address.
+type ViewSalt struct {
+	mgm.DefaultModel `bson:",inline"`
+	Day              string `bson:"day"`
+	Salt             string `bson:"salt"`
+}
+
+// ViewVisitor records that a visitor, identified only by a salted hash, has
+// seen a piece of content on a day, so repeat views do not count as new
+// visitors. These expire with the salt.
+type ViewVisitor struct {
+	mgm.DefaultModel `bson:",inline"`
+	Day              string             `bson:"day"`
+	Entity           string             `bson:"entity"`
+	EntityID         primitive.ObjectID `bson:"entity_id"`
+	Visitor          string             `bson:"visitor"`
+}
+
+// ContentViewDay counts the views of one piece of content on one day.
+type ContentViewDay struct {
+	mgm.DefaultModel `bson:",inline"`
+	Day              string             `bson:"day"`
+	Entity           string             `bson:"entity"`
+	EntityID         primitive.ObjectID `bson:"entity_id"`
+	Views            int                `bson:"views"`
+	Visitors         int                `bson:"visitors"`
+}
+
+// ReferrerViewDay counts the visitors one referring site sent to a piece of
+// content on one day.
+type ReferrerViewDay struct {
+	mgm.DefaultModel `bson:",inline"`
+	Day              string             `bson:"day"`
+	Entity           string             `bson:"entity"`
+	EntityID         primitive.ObjectID `bson:"entity_id"`
+	Referrer         string             `bson:"referrer"`
+	Visitors         int                `bson:"visitors"`
+}
+
+// LinkClickVisitor is ViewVisitor for clicks on one outbound link of a piece
+// of content.
+type LinkClickVisitor struct {
+	mgm.DefaultModel `bson:",inline"`
+	Day              string             `bson:"day"`
+	Entity           string             `bson:"entity"`
+	EntityID         primitive.ObjectID `bson:"entity_id"`
+	Link             string             `bson:"link"`
+	Visitor          string             `bson:"visitor"`
+}
+
+// LinkClickDay counts the clicks on one outbound link of a piece of content
+// on one day. Link is the JSON name of the field holding the URL.
+type LinkClickDay struct {
+	mgm.DefaultModel `bson:",inline"`
+	Day              string             `bson:"day"`
+	Entity           string             `bson:"entity"`
+	EntityID         primitive.ObjectID `bson:"entity_id"`
+	Link             string             `bson:"link"`
+	Clicks           int                `bson:"clicks"`
+	Visitors         int                `bson:"visitors"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/MishraShardendu22/util"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CertificationActive       = "active"
+	CertificationExpiringSoon = "expiring_soon"
+	CertificationExpired      = "expired"
+	CertificationNoExpiry     = "no_expiry"
+)
+
+func IsCertificationStatus(status string) bool {
+	switch status {
+	case CertificationActive, CertificationExpiringSoon, CertificationExpired, CertificationNoExpiry:
+		return true
+	}
+	return false
+}
+
+// CertificationExpiryAlert records that admins were told a certification
+// expires on ExpiryDate, so the daily expiry job alerts once per expiry date.
+// Renewing the certification (changing its expiry date) re-arms the alert.
+type CertificationExpiryAlert struct {
+	mgm.DefaultModel `bson:",inline"`
+	CertificationID  primitive.ObjectID `bson:"certification_id"`
+	ExpiryDate       string             `bson:"expiry_date"`
+	NotifiedAt       time.Time          `bson:"notified_at"`
+}
+
+// ExpiresIn reports the number of whole days until the certification expires.
+// ok is false when there is no expiry date or it cannot be parsed.
+func (c *CertificationOrAchievements) ExpiresIn(now time.Time) (days int, ok bool) {
+	expiry, ok := util.ParseDate(c.ExpiryDate)
+	if !ok {
+		return 0, false
+	}
+	return util.DaysUntil(expiry, now), true
+}
+
+// ComputeStatus fills Status from ExpiryDate. Certifications expiring within
+// soonDays (inclusive) are reported as expiring soon.
+func (c *CertificationOrAchievements) ComputeStatus(now time.Time, soonDays int) string {
+	days, ok := c.ExpiresIn(now)
+	switch {
+	case !ok:
+		c.Status = CertificationNoExpiry
+	case days < 0:
+		c.Status = CertificationExpired
+	case days <= soonDays:
+		c.Status = CertificationExpiringSoon
+	default:
+		c.Status = CertificationActive
+	}
+	return c.Status
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeStatus(t *testing.T) {
+	now := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		expiry string
+		want   string
+	}{
+		{"no expiry date", "", CertificationNoExpiry},
+		{"unparseable", "someday", CertificationNoExpiry},
+		{"expired yesterday", "2024-03-09", CertificationExpired},
+		{"expires today", "2024-03-10", CertificationExpiringSoon},
+		{"last day of the window", "2024-04-09", CertificationExpiringSoon},
+		{"just past the window", "2024-04-10", CertificationActive},
+		// Month-only dates stay valid until the end of the month
+		{"expiry month", "March 2024", CertificationExpiringSoon},
+		{"past expiry month", "February 2024", CertificationExpired},
+	}
+
+	for _, tt := range tests {
+		cert := CertificationOrAchievements{ExpiryDate: tt.expiry}
+		if got := cert.ComputeStatus(now, 30); got != tt.want || cert.Status != tt.want {
+			t.Errorf("%s: ComputeStatus = %q (Status %q), want %q", tt.name, got, cert.Status, tt.want)
+		}
+	}
+}
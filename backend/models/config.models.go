@@ -1,6 +1,10 @@
 package models
 
-import "github.com/kamva/mgm/v3"
+import (
+	"time"
+
+	"github.com/kamva/mgm/v3"
+)
 
 type Config struct {
 	Port             string
@@ -11,6 +15,55 @@ type Config struct {
 	DbName           string
 	AdminPass        string
 	JWT_SECRET       string
+
+	CertExpiryWindowDays int
+	ExpiryNotifier       string
+	ExpiryNotifyURL      string
+	DeletePolicy         string
+	MigrateOnStart       bool
+	CacheControl         string
+	ReadCacheTTL         time.Duration
+	ReadCacheMaxEntries  int
+
+	// LegacyAPIDeprecatedAt and LegacyAPISunset are dates announced on the
+	// unversioned /api routes through the Deprecation and Sunset headers.
+	LegacyAPIDeprecatedAt string
+	LegacyAPISunset       string
+
+	// GraphQLMaxDepth and GraphQLMaxComplexity bound /graphql queries; zero
+	// disables a limit.
+	GraphQLMaxDepth      int
+	GraphQLMaxComplexity int
+
+	// GRPCPort is where the gRPC server listens; empty disables it.
+	GRPCPort string
+
+	// WebhookMaxAttempts is how often a webhook delivery is tried before it
+	// is marked failed; WebhookRetryBase is the first retry delay, doubled
+	// after every further failure.
+	WebhookMaxAttempts int
+	WebhookRetryBase   time.Duration
+
+	// ViewAnalytics turns view counting on content detail routes on or off.
+	ViewAnalytics bool
+	// ProxyHeader names the header holding the client IP, e.g.
+	// X-Forwarded-For, when the API runs behind a proxy.
+	ProxyHeader string
+
+	// SMTPHost is the mail server; empty disables email notifications.
+	// SMTPTLS is starttls, tls (implicit, usually port 465) or none.
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	SMTPTLS      string
+	// MailFrom is the sender and MailAdminTo the admins notified by email.
+	MailFrom    string
+	MailAdminTo []string
+	// MailMaxAttempts and MailRetryBase control retries like their webhook
+	// counterparts.
+	MailMaxAttempts int
+	MailRetryBase   time.Duration
 }
 
 type TestModel struct {
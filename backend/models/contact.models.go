@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/kamva/mgm/v3"
+)
+
+// ContactMessage is a message sent through the public contact form.
+type ContactMessage struct {
+	mgm.DefaultModel `bson:",inline"`
+	Name             string `bson:"name"`
+	Email            string `bson:"email"`
+	Message          string `bson:"message"`
+	// Spam is set by the submission heuristics, or by the admin; SpamReasons
+	// says which heuristics matched.
+	Spam        bool       `bson:"spam"`
+	SpamReasons []string   `bson:"spam_reasons,omitempty"`
+	ReadAt      *time.Time `bson:"read_at,omitempty"`
+	HandledAt   *time.Time `bson:"handled_at,omitempty"`
+	ArchivedAt  *time.Time `bson:"archived_at,omitempty"`
+}
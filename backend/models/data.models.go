@@ -9,8 +9,8 @@ import (
 type User struct {
 	mgm.DefaultModel `bson:",inline" json:"inline"`
 	Email            string               `bson:"email" json:"email"`
-	Password         string               `bson:"password" json:"password"`
-	AdminPass        string               `bson:"admin_pass" json:"admin_pass"`
+	Password         string               `bson:"password" json:"-"`
+	AdminPass        string               `bson:"admin_pass" json:"-"`
 	Skills           []string             `bson:"skills" json:"skills"`
 	Projects         []primitive.ObjectID `bson:"projects" json:"projects"`
 	Experiences      []primitive.ObjectID `bson:"experiences" json:"experiences"`
@@ -26,6 +26,7 @@ type Project struct {
 	ProjectRepository string   `bson:"project_repository" json:"project_repository"`
 	ProjectLiveLink   string   `bson:"project_live_link" json:"project_live_link"`
 	ProjectVideo      string   `bson:"project_video" json:"project_video"`
+	Version           int64    `bson:"version" json:"version"`
 }
 
 type Experience struct {
@@ -41,6 +42,7 @@ type Experience struct {
 	CompanyLogo      string               `bson:"company_logo" json:"company_logo"`
 	CertificateURL   string               `bson:"certificate_url" json:"certificate_url"`
 	Images           []string             `bson:"images" json:"images"`
+	Version          int64                `bson:"version" json:"version"`
 }
 
 type CertificationOrAchievements struct {
@@ -54,4 +56,6 @@ type CertificationOrAchievements struct {
 	Issuer           string               `bson:"issuer" json:"issuer"`
 	IssueDate        string               `bson:"issue_date" json:"issue_date"`
 	ExpiryDate       string               `bson:"expiry_date" json:"expiry_date"`
+	Version          int64                `bson:"version" json:"version"`
+	Status           string               `bson:"-" json:"status,omitempty"`
 }
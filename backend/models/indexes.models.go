@@ -0,0 +1,152 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Index names are fixed so that EnsureIndexes can recognise indexes it
+// already created and report declared indexes that are missing.
+
+func (*User) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "email", Value: 1}}, Options: options.Index().SetName("email_unique").SetUnique(true)},
+	}
+}
+
+func (*Project) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "skills", Value: 1}}, Options: options.Index().SetName("skills")},
+		{Keys: bson.D{{Key: "created_at", Value: -1}}, Options: options.Index().SetName("created_at")},
+		{
+			Keys: bson.D{{Key: "project_name", Value: "text"}, {Key: "small_description", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().SetName("text_search").
+				SetWeights(bson.D{{Key: "project_name", Value: 10}, {Key: "small_description", Value: 5}, {Key: "description", Value: 1}}),
+		},
+	}
+}
+
+func (*Experience) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "projects", Value: 1}}, Options: options.Index().SetName("projects")},
+		{Keys: bson.D{{Key: "technologies", Value: 1}}, Options: options.Index().SetName("technologies")},
+		{Keys: bson.D{{Key: "start_date", Value: -1}}, Options: options.Index().SetName("start_date")},
+		{Keys: bson.D{{Key: "created_at", Value: -1}}, Options: options.Index().SetName("created_at")},
+		{
+			Keys: bson.D{{Key: "company_name", Value: "text"}, {Key: "position", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().SetName("text_search").
+				SetWeights(bson.D{{Key: "company_name", Value: 10}, {Key: "position", Value: 5}, {Key: "description", Value: 1}}),
+		},
+	}
+}
+
+func (*CertificationOrAchievements) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "projects", Value: 1}}, Options: options.Index().SetName("projects")},
+		{Keys: bson.D{{Key: "skills", Value: 1}}, Options: options.Index().SetName("skills")},
+		{Keys: bson.D{{Key: "issue_date", Value: -1}}, Options: options.Index().SetName("issue_date")},
+		{Keys: bson.D{{Key: "expiry_date", Value: 1}}, Options: options.Index().SetName("expiry_date")},
+		{Keys: bson.D{{Key: "created_at", Value: -1}}, Options: options.Index().SetName("created_at")},
+		{
+			Keys: bson.D{{Key: "title", Value: "text"}, {Key: "issuer", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().SetName("text_search").
+				SetWeights(bson.D{{Key: "title", Value: 10}, {Key: "issuer", Value: 5}, {Key: "description", Value: 1}}),
+		},
+	}
+}
+
+func (*WebhookSubscription) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "active", Value: 1}}, Options: options.Index().SetName("active")},
+	}
+}
+
+// Deliveries are kept for 30 days as the delivery log.
+func (*WebhookDelivery) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}}, Options: options.Index().SetName("due")},
+		{Keys: bson.D{{Key: "subscription_id", Value: 1}, {Key: "created_at", Value: -1}}, Options: options.Index().SetName("subscription")},
+		{Keys: bson.D{{Key: "created_at", Value: 1}}, Options: options.Index().SetName("created_at_ttl").SetExpireAfterSeconds(30 * 24 * 60 * 60)},
+	}
+}
+
+// Salts and visitor hashes are deleted two days after they are created, which
+// is after their day has ended in every time zone.
+func (*ViewSalt) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "day", Value: 1}}, Options: options.Index().SetName("day_unique").SetUnique(true)},
+		{Keys: bson.D{{Key: "created_at", Value: 1}}, Options: options.Index().SetName("created_at_ttl").SetExpireAfterSeconds(2 * 24 * 60 * 60)},
+	}
+}
+
+func (*ViewVisitor) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "day", Value: 1}, {Key: "entity", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "visitor", Value: 1}},
+			Options: options.Index().SetName("visit_unique").SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "created_at", Value: 1}}, Options: options.Index().SetName("created_at_ttl").SetExpireAfterSeconds(2 * 24 * 60 * 60)},
+	}
+}
+
+func (*ContentViewDay) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "entity", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "day", Value: 1}},
+			Options: options.Index().SetName("content_day_unique").SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "day", Value: 1}}, Options: options.Index().SetName("day")},
+	}
+}
+
+func (*ReferrerViewDay) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "entity", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "day", Value: 1}, {Key: "referrer", Value: 1}},
+			Options: options.Index().SetName("content_day_referrer_unique").SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "day", Value: 1}}, Options: options.Index().SetName("day")},
+	}
+}
+
+func (*LinkClickVisitor) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "day", Value: 1}, {Key: "entity", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "link", Value: 1}, {Key: "visitor", Value: 1}},
+			Options: options.Index().SetName("click_unique").SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "created_at", Value: 1}}, Options: options.Index().SetName("created_at_ttl").SetExpireAfterSeconds(2 * 24 * 60 * 60)},
+	}
+}
+
+func (*LinkClickDay) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "entity", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "link", Value: 1}, {Key: "day", Value: 1}},
+			Options: options.Index().SetName("content_link_day_unique").SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "day", Value: 1}}, Options: options.Index().SetName("day")},
+	}
+}
+
+func (*ContactMessage) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "created_at", Value: -1}}, Options: options.Index().SetName("created_at")},
+		{Keys: bson.D{{Key: "email", Value: 1}, {Key: "created_at", Value: -1}}, Options: options.Index().SetName("email")},
+	}
+}
+
+func (*CertificationExpiryAlert) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "certification_id", Value: 1}, {Key: "expiry_date", Value: 1}}, Options: options.Index().SetName("certification_expiry_unique").SetUnique(true)},
+	}
+}
+
+// Mails are kept for 30 days, so recent failures can be looked into.
+func (*MailMessage) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}}, Options: options.Index().SetName("due")},
+		{Keys: bson.D{{Key: "created_at", Value: 1}}, Options: options.Index().SetName("created_at_ttl").SetExpireAfterSeconds(30 * 24 * 60 * 60)},
+	}
+}
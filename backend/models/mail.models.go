@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/kamva/mgm/v3"
+)
+
+// Mail statuses. Pending mails are retried until they are sent or run out
+// of attempts.
+const (
+	MailPending = "pending"
+	MailSent    = "sent"
+	MailFailed  = "failed"
+)
+
+// MailMessage is a rendered email in the outbox.
+type MailMessage struct {
+	mgm.DefaultModel `bson:",inline"`
+	// Event is the notification the mail was rendered from.
+	Event         string     `bson:"event"`
+	To            []string   `bson:"to"`
+	Subject       string     `bson:"subject"`
+	Text          string     `bson:"text"`
+	HTML          string     `bson:"html"`
+	Status        string     `bson:"status"`
+	Attempts      int        `bson:"attempts"`
+	NextAttemptAt time.Time  `bson:"next_attempt_at"`
+	LastError     string     `bson:"last_error,omitempty"`
+	SentAt        *time.Time `bson:"sent_at,omitempty"`
+}
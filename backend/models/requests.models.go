@@ -0,0 +1,121 @@
+package models
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// Request bodies accepted by the write endpoints. Rules are declared in
+// `validate` tags and checked by util.Validate before anything is stored.
+
+type ProjectRequest struct {
+	ProjectName       string   `json:"project_name" validate:"required,max=120"`
+	SmallDescription  string   `json:"small_description" validate:"required,max=300"`
+	Description       string   `json:"description" validate:"required,max=10000"`
+	Skills            []string `json:"skills" validate:"max=50,dive,required,max=50"`
+	ProjectRepository string   `json:"project_repository" validate:"omitempty,url,max=500"`
+	ProjectLiveLink   string   `json:"project_live_link" validate:"omitempty,url,max=500"`
+	ProjectVideo      string   `json:"project_video" validate:"omitempty,url,max=500"`
+}
+
+func (r ProjectRequest) Model() Project {
+	return Project{
+		ProjectName:       r.ProjectName,
+		SmallDescription:  r.SmallDescription,
+		Description:       r.Description,
+		Skills:            r.Skills,
+		ProjectRepository: r.ProjectRepository,
+		ProjectLiveLink:   r.ProjectLiveLink,
+		ProjectVideo:      r.ProjectVideo,
+	}
+}
+
+type ExperienceRequest struct {
+	CompanyName    string               `json:"company_name" validate:"required,max=120"`
+	Position       string               `json:"position" validate:"required,max=120"`
+	StartDate      string               `json:"start_date" validate:"required,date"`
+	EndDate        string               `json:"end_date" validate:"omitempty,date_or_present"`
+	Description    string               `json:"description" validate:"max=10000"`
+	Technologies   []string             `json:"technologies" validate:"max=50,dive,required,max=50"`
+	CreatedBy      string               `json:"created_by" validate:"max=120"`
+	Projects       []primitive.ObjectID `json:"projects" validate:"max=100"`
+	CompanyLogo    string               `json:"company_logo" validate:"omitempty,url,max=500"`
+	CertificateURL string               `json:"certificate_url" validate:"omitempty,url,max=500"`
+	Images         []string             `json:"images" validate:"max=20,dive,url,max=500"`
+}
+
+func (r ExperienceRequest) Model() Experience {
+	return Experience{
+		CompanyName:    r.CompanyName,
+		Position:       r.Position,
+		StartDate:      r.StartDate,
+		EndDate:        r.EndDate,
+		Description:    r.Description,
+		Technologies:   r.Technologies,
+		CreatedBy:      r.CreatedBy,
+		Projects:       r.Projects,
+		CompanyLogo:    r.CompanyLogo,
+		CertificateURL: r.CertificateURL,
+		Images:         r.Images,
+	}
+}
+
+type CertificationRequest struct {
+	Title          string               `json:"title" validate:"required,max=200"`
+	Description    string               `json:"description" validate:"required,max=5000"`
+	Projects       []primitive.ObjectID `json:"projects" validate:"max=100"`
+	Skills         []string             `json:"skills" validate:"max=50,dive,required,max=50"`
+	CertificateURL string               `json:"certificate_url" validate:"omitempty,url,max=500"`
+	Images         []string             `json:"images" validate:"max=20,dive,url,max=500"`
+	Issuer         string               `json:"issuer" validate:"required,max=120"`
+	IssueDate      string               `json:"issue_date" validate:"omitempty,date"`
+	ExpiryDate     string               `json:"expiry_date" validate:"omitempty,date"`
+}
+
+func (r CertificationRequest) Model() CertificationOrAchievements {
+	return CertificationOrAchievements{
+		Title:          r.Title,
+		Description:    r.Description,
+		Projects:       r.Projects,
+		Skills:         r.Skills,
+		CertificateURL: r.CertificateURL,
+		Images:         r.Images,
+		Issuer:         r.Issuer,
+		IssueDate:      r.IssueDate,
+		ExpiryDate:     r.ExpiryDate,
+	}
+}
+
+type SkillsRequest struct {
+	Skills []string `json:"skills" validate:"required,min=1,max=100,dive,required,max=50"`
+}
+
+type AdminLoginRequest struct {
+	Email     string `json:"email" validate:"required,email,max=254"`
+	Password  string `json:"password" validate:"required,max=128"`
+	AdminPass string `json:"admin_pass"`
+}
+
+type WebhookRequest struct {
+	URL         string   `json:"url" validate:"required,http_url,max=2048"`
+	Events      []string `json:"events" validate:"required,min=1,max=20,dive,event_pattern"`
+	Description string   `json:"description" validate:"max=200"`
+	// Active defaults to true
+	Active *bool `json:"active"`
+	// Secret signs deliveries; one is generated when omitted
+	Secret string `json:"secret" validate:"omitempty,min=16,max=256"`
+}
+
+type ContactRequest struct {
+	Name    string `json:"name" validate:"required,notblank,max=100"`
+	Email   string `json:"email" validate:"required,email,max=254"`
+	Message string `json:"message" validate:"required,notblank,min=10,max=5000"`
+	// Website is a honeypot: the form hides it from people, so only bots
+	// fill it in.
+	Website string `json:"website" validate:"max=500"`
+}
+
+// ContactUpdateRequest changes the state of a contact message; omitted
+// fields are left as they are.
+type ContactUpdateRequest struct {
+	Handled  *bool `json:"handled"`
+	Archived *bool `json:"archived"`
+	Spam     *bool `json:"spam"`
+}
@@ -0,0 +1,279 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Response bodies returned by the API. Handlers never serialize the stored
+// models directly, so a field only reaches clients once it is listed here.
+
+type UserResponse struct {
+	ID             string               `json:"id"`
+	Email          string               `json:"email"`
+	Skills         []string             `json:"skills"`
+	Projects       []primitive.ObjectID `json:"projects"`
+	Experiences    []primitive.ObjectID `json:"experiences"`
+	Certifications []primitive.ObjectID `json:"certifications"`
+	CreatedAt      time.Time            `json:"created_at"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+}
+
+func (u User) Response() UserResponse {
+	return UserResponse{
+		ID:             u.ID.Hex(),
+		Email:          u.Email,
+		Skills:         u.Skills,
+		Projects:       u.Projects,
+		Experiences:    u.Experiences,
+		Certifications: u.Certifications,
+		CreatedAt:      u.CreatedAt,
+		UpdatedAt:      u.UpdatedAt,
+	}
+}
+
+type ProjectResponse struct {
+	ID                string    `json:"id"`
+	ProjectName       string    `json:"project_name"`
+	SmallDescription  string    `json:"small_description"`
+	Description       string    `json:"description"`
+	Skills            []string  `json:"skills"`
+	ProjectRepository string    `json:"project_repository"`
+	ProjectLiveLink   string    `json:"project_live_link"`
+	ProjectVideo      string    `json:"project_video"`
+	Version           int64     `json:"version"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+func (p Project) Response() ProjectResponse {
+	return ProjectResponse{
+		ID:                p.ID.Hex(),
+		ProjectName:       p.ProjectName,
+		SmallDescription:  p.SmallDescription,
+		Description:       p.Description,
+		Skills:            p.Skills,
+		ProjectRepository: p.ProjectRepository,
+		ProjectLiveLink:   p.ProjectLiveLink,
+		ProjectVideo:      p.ProjectVideo,
+		Version:           p.Version,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
+
+func ProjectResponses(projects []Project) []ProjectResponse {
+	out := make([]ProjectResponse, len(projects))
+	for i, p := range projects {
+		out[i] = p.Response()
+	}
+	return out
+}
+
+type ExperienceResponse struct {
+	ID             string               `json:"id"`
+	CompanyName    string               `json:"company_name"`
+	Position       string               `json:"position"`
+	StartDate      string               `json:"start_date"`
+	EndDate        string               `json:"end_date"`
+	Description    string               `json:"description"`
+	Technologies   []string             `json:"technologies"`
+	CreatedBy      string               `json:"created_by"`
+	Projects       []primitive.ObjectID `json:"projects"`
+	CompanyLogo    string               `json:"company_logo"`
+	CertificateURL string               `json:"certificate_url"`
+	Images         []string             `json:"images"`
+	Version        int64                `json:"version"`
+	CreatedAt      time.Time            `json:"created_at"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+}
+
+func (e Experience) Response() ExperienceResponse {
+	return ExperienceResponse{
+		ID:             e.ID.Hex(),
+		CompanyName:    e.CompanyName,
+		Position:       e.Position,
+		StartDate:      e.StartDate,
+		EndDate:        e.EndDate,
+		Description:    e.Description,
+		Technologies:   e.Technologies,
+		CreatedBy:      e.CreatedBy,
+		Projects:       e.Projects,
+		CompanyLogo:    e.CompanyLogo,
+		CertificateURL: e.CertificateURL,
+		Images:         e.Images,
+		Version:        e.Version,
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
+	}
+}
+
+func ExperienceResponses(exps []Experience) []ExperienceResponse {
+	out := make([]ExperienceResponse, len(exps))
+	for i, e := range exps {
+		out[i] = e.Response()
+	}
+	return out
+}
+
+type CertificationResponse struct {
+	ID             string               `json:"id"`
+	Title          string               `json:"title"`
+	Description    string               `json:"description"`
+	Projects       []primitive.ObjectID `json:"projects"`
+	Skills         []string             `json:"skills"`
+	CertificateURL string               `json:"certificate_url"`
+	Images         []string             `json:"images"`
+	Issuer         string               `json:"issuer"`
+	IssueDate      string               `json:"issue_date"`
+	ExpiryDate     string               `json:"expiry_date"`
+	Status         string               `json:"status,omitempty"`
+	Version        int64                `json:"version"`
+	CreatedAt      time.Time            `json:"created_at"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+}
+
+func (c CertificationOrAchievements) Response() CertificationResponse {
+	return CertificationResponse{
+		ID:             c.ID.Hex(),
+		Title:          c.Title,
+		Description:    c.Description,
+		Projects:       c.Projects,
+		Skills:         c.Skills,
+		CertificateURL: c.CertificateURL,
+		Images:         c.Images,
+		Issuer:         c.Issuer,
+		IssueDate:      c.IssueDate,
+		ExpiryDate:     c.ExpiryDate,
+		Status:         c.Status,
+		Version:        c.Version,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+	}
+}
+
+func CertificationResponses(certs []CertificationOrAchievements) []CertificationResponse {
+	out := make([]CertificationResponse, len(certs))
+	for i, c := range certs {
+		out[i] = c.Response()
+	}
+	return out
+}
+
+type WebhookSubscriptionResponse struct {
+	ID          string   `json:"id"`
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Description string   `json:"description"`
+	Active      bool     `json:"active"`
+	// Secret is only returned when the subscription is created
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (s WebhookSubscription) Response() WebhookSubscriptionResponse {
+	return WebhookSubscriptionResponse{
+		ID:          s.ID.Hex(),
+		URL:         s.URL,
+		Events:      s.Events,
+		Description: s.Description,
+		Active:      s.Active,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+func WebhookSubscriptionResponses(subs []WebhookSubscription) []WebhookSubscriptionResponse {
+	out := make([]WebhookSubscriptionResponse, len(subs))
+	for i, s := range subs {
+		out[i] = s.Response()
+	}
+	return out
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	RedeliveryOf   string          `json:"redelivery_of,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+func (d WebhookDelivery) Response() WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		ID:             d.ID.Hex(),
+		SubscriptionID: d.SubscriptionID.Hex(),
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        json.RawMessage(d.Payload),
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+	}
+	if d.Status == DeliveryPending {
+		next := d.NextAttemptAt
+		resp.NextAttemptAt = &next
+	}
+	if d.RedeliveryOf != nil {
+		resp.RedeliveryOf = d.RedeliveryOf.Hex()
+	}
+	return resp
+}
+
+func WebhookDeliveryResponses(deliveries []WebhookDelivery) []WebhookDeliveryResponse {
+	out := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		out[i] = d.Response()
+	}
+	return out
+}
+
+type ContactMessageResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Email       string     `json:"email"`
+	Message     string     `json:"message"`
+	Spam        bool       `json:"spam"`
+	SpamReasons []string   `json:"spam_reasons,omitempty"`
+	ReadAt      *time.Time `json:"read_at,omitempty"`
+	HandledAt   *time.Time `json:"handled_at,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func (m ContactMessage) Response() ContactMessageResponse {
+	return ContactMessageResponse{
+		ID:          m.ID.Hex(),
+		Name:        m.Name,
+		Email:       m.Email,
+		Message:     m.Message,
+		Spam:        m.Spam,
+		SpamReasons: m.SpamReasons,
+		ReadAt:      m.ReadAt,
+		HandledAt:   m.HandledAt,
+		ArchivedAt:  m.ArchivedAt,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func ContactMessageResponses(msgs []ContactMessage) []ContactMessageResponse {
+	out := make([]ContactMessageResponse, len(msgs))
+	for i, m := range msgs {
+		out[i] = m.Response()
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// WebhookSubscription receives signed POSTs for the content events matching
+// one of its Events patterns ("project.created", "project.*" or "*").
+type WebhookSubscription struct {
+	mgm.DefaultModel `bson:",inline"`
+	URL              string   `bson:"url"`
+	Secret           string   `bson:"secret"`
+	Events           []string `bson:"events"`
+	Description      string   `bson:"description"`
+	Active           bool     `bson:"active"`
+}
+
+// Delivery statuses. Pending deliveries are retried until they succeed or
+// run out of attempts.
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed"
+)
+
+// WebhookDelivery is one event sent to one subscription, with the outcome
+// of its latest attempt. Payload is the exact body that is signed and sent.
+type WebhookDelivery struct {
+	mgm.DefaultModel `bson:",inline"`
+	SubscriptionID   primitive.ObjectID  `bson:"subscription_id"`
+	EventID          string              `bson:"event_id"`
+	EventType        string              `bson:"event_type"`
+	Payload          string              `bson:"payload"`
+	Status           string              `bson:"status"`
+	Attempts         int                 `bson:"attempts"`
+	NextAttemptAt    time.Time           `bson:"next_attempt_at"`
+	LastStatusCode   int                 `bson:"last_status_code,omitempty"`
+	LastError        string              `bson:"last_error,omitempty"`
+	DeliveredAt      *time.Time          `bson:"delivered_at,omitempty"`
+	RedeliveryOf     *primitive.ObjectID `bson:"redelivery_of,omitempty"`
+}
@@ -0,0 +1,83 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+type Notification struct {
+	Event   string    `json:"event"`
+	Subject string    `json:"subject"`
+	Message string    `json:"message"`
+	Data    any       `json:"data,omitempty"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// Notifier delivers notifications produced by background jobs. Implementations
+// must be safe for concurrent use.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+type LogNotifier struct {
+	Logger *slog.Logger
+}
+
+func (l LogNotifier) Notify(ctx context.Context, n Notification) error {
+	logger := l.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.InfoContext(ctx, n.Subject, "event", n.Event, "message", n.Message, "data", n.Data)
+	return nil
+}
+
+// Multi fans a notification out to every notifier and joins their errors.
+type Multi []Notifier
+
+func (m Multi) Notify(ctx context.Context, n Notification) error {
+	var errs []error
+	for _, notifier := range m {
+		if err := notifier.Notify(ctx, n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// New builds the notifier selected by name. Unknown names fall back to logging.
+func New(name string, webhookURL string, logger *slog.Logger) Notifier {
+	logNotifier := LogNotifier{Logger: logger}
+	switch name {
+	case "webhook":
+		if webhookURL == "" {
+			logger.Warn("webhook notifier selected without a URL, falling back to log notifier")
+			return logNotifier
+		}
+		return Multi{logNotifier, WebhookNotifier{URL: webhookURL}}
+	default:
+		return logNotifier
+	}
+}
+
+// JobFailedEvent is the event of notifications about failed background jobs.
+const JobFailedEvent = "job.failed"
+
+type JobFailure struct {
+	Job   string `json:"job"`
+	Error string `json:"error"`
+}
+
+// JobFailed builds the notification for a background job that failed with
+// err. message says what was affected.
+func JobFailed(job, message string, err error) Notification {
+	return Notification{
+		Event:   JobFailedEvent,
+		Subject: "Background job failed: " + job,
+		Message: message,
+		Data:    JobFailure{Job: job, Error: err.Error()},
+		SentAt:  time.Now().UTC(),
+	}
+}
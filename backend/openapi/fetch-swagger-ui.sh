@@ -0,0 +1,12 @@
+#!/bin/sh
+# Downloads the pinned swagger-ui-dist release into swagger-ui/ so the
+# explorer can serve it from the binary instead of a CDN.
+set -eu
+
+version=5.17.14
+dir=$(dirname "$0")/swagger-ui
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+
+curl -fsSL "https://registry.npmjs.org/swagger-ui-dist/-/swagger-ui-dist-$version.tgz" | tar -xz -C "$tmp"
+cp "$tmp/package/swagger-ui.css" "$tmp/package/swagger-ui-bundle.js" "$dir/"
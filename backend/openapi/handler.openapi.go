@@ -0,0 +1,66 @@
+package openapi
+
+import (
+	"embed"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate sh fetch-swagger-ui.sh
+
+//go:embed explorer.html
+var explorerPage string
+
+// swaggerUI holds the vendored swagger-ui-dist files, if they were fetched.
+//
+//go:embed swagger-ui
+var swaggerUI embed.FS
+
+// swaggerUICDN is the pinned release the explorer loads when the assets were
+// not vendored.
+const swaggerUICDN = "https://unpkg.com/swagger-ui-dist@5.17.14"
+
+// Handler serves the document for app's routes under prefix. It is built on
+// the first request, once every route has been registered.
+func Handler(app *fiber.App, prefix, title, version string) fiber.Handler {
+	var once sync.Once
+	var doc map[string]any
+	return func(c *fiber.Ctx) error {
+		once.Do(func() {
+			doc = Build(app.GetRoutes(true), prefix, title, version)
+		})
+		return c.JSON(doc)
+	}
+}
+
+// Explorer serves an interactive API explorer for the document at specURL.
+// Mount it on a wildcard route, e.g. "/api/docs/*", so it can also serve the
+// vendored swagger-ui assets below it.
+func Explorer(specURL string) fiber.Handler {
+	assets, _ := fs.Sub(swaggerUI, "swagger-ui")
+	_, err := fs.Stat(assets, "swagger-ui-bundle.js")
+	vendored := err == nil
+
+	page := strings.ReplaceAll(explorerPage, "{{SPEC_URL}}", specURL)
+	return func(c *fiber.Ctx) error {
+		if name := c.Params("*"); name != "" {
+			asset, err := fs.ReadFile(assets, name)
+			if err != nil {
+				return fiber.ErrNotFound
+			}
+			c.Type(strings.TrimPrefix(path.Ext(name), "."))
+			return c.Send(asset)
+		}
+
+		assetsURL := swaggerUICDN
+		if vendored {
+			assetsURL = strings.TrimSuffix(c.Path(), "/")
+		}
+		c.Type("html")
+		return c.SendString(strings.ReplaceAll(page, "{{ASSETS_URL}}", assetsURL))
+	}
+}
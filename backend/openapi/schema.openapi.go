@@ -0,0 +1,184 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Schema is the subset of the OpenAPI 3 schema object the generator emits.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	objectIDType = reflect.TypeOf(primitive.ObjectID{})
+)
+
+// schemas collects named struct schemas into components while reflecting.
+type schemas map[string]*Schema
+
+// of returns the schema for v's type. Named structs are added to s and
+// referenced, so each type is described once.
+func (s schemas) of(v any) *Schema {
+	if v == nil {
+		return &Schema{Type: "object"}
+	}
+	return s.typeSchema(reflect.TypeOf(v))
+}
+
+func (s schemas) typeSchema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case objectIDType:
+		return &Schema{Type: "string", Pattern: "^[0-9a-f]{24}$"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: s.typeSchema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.typeSchema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.structSchema(t)
+		}
+		name := t.Name()
+		if _, ok := s[name]; !ok {
+			s[name] = &Schema{} // placeholder breaks recursion
+			s[name] = s.structSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + name}
+	default:
+		// interfaces such as bson.M values or `any`
+		return &Schema{}
+	}
+}
+
+func (s schemas) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	s.addFields(schema, t)
+	return schema
+}
+
+func (s schemas) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		// Embedded structs without a JSON name are flattened, like encoding/json does
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				s.addFields(schema, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		prop := s.typeSchema(f.Type)
+		if applyRules(prop, f.Tag.Get("validate")) {
+			schema.Required = append(schema.Required, name)
+		}
+		schema.Properties[name] = prop
+	}
+}
+
+// applyRules copies the go-playground/validator rules that have an OpenAPI
+// equivalent onto prop and reports whether the field is required. Rules after
+// `dive` apply to slice items.
+func applyRules(prop *Schema, tag string) (required bool) {
+	if tag == "" || prop.Ref != "" {
+		return false
+	}
+
+	target := prop
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		n, numErr := strconv.Atoi(param)
+
+		switch name {
+		case "required":
+			if target == prop {
+				required = true
+			} else if target.Type == "string" {
+				one := 1
+				target.MinLength = &one
+			}
+		case "dive":
+			if prop.Items == nil {
+				return required
+			}
+			target = prop.Items
+		case "max", "min":
+			if numErr != nil {
+				continue
+			}
+			switch {
+			case target.Type == "array" && name == "max":
+				target.MaxItems = &n
+			case target.Type == "array":
+				target.MinItems = &n
+			case name == "max":
+				target.MaxLength = &n
+			default:
+				target.MinLength = &n
+			}
+		case "url", "http_url":
+			target.Format = "uri"
+		case "email":
+			target.Format = "email"
+		case "date", "date_or_present":
+			target.Description = "Date such as 2024-01-31, Jan 2, 2024 or Jan 2024"
+			if name == "date_or_present" {
+				target.Description += ", or Present"
+			}
+		case "oneof":
+			target.Enum = strings.Fields(param)
+		}
+	}
+	return required
+}
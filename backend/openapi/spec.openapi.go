@@ -0,0 +1,297 @@
+package openapi
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Param documents a query parameter or request header.
+type Param struct {
+	Name        string
+	In          string // "query" (default) or "header"
+	Description string
+	Type        string // "string" (default), "integer" or "boolean"
+	Enum        []string
+}
+
+// Operation documents one route. Path uses fiber syntax relative to the API
+// version prefix, e.g. "/projects/:id", or to the app root for Root routes.
+type Operation struct {
+	Method  string
+	Path    string
+	Summary string
+	Tag     string
+	// Auth marks routes behind JWTMiddleware.
+	Auth   bool
+	Params []Param
+	// Request is a value of the request body type, nil when there is none.
+	Request any
+	// Response is a value of the `data` type in the success envelope.
+	Response any
+	// Raw responses are sent as-is instead of inside the success envelope.
+	Raw bool
+	// ContentType of the success response, application/json when empty. For
+	// streams, Response documents one message.
+	ContentType string
+	// Redirect routes answer 302 with a Location header instead of 200.
+	Redirect bool
+	// Root routes are mounted on the app root rather than under the version
+	// prefix.
+	Root bool
+	// Errors lists statuses beyond those implied by Auth, path parameters
+	// and Request.
+	Errors []int
+}
+
+var (
+	mu         sync.RWMutex
+	operations = map[string]Operation{}
+)
+
+func key(method, path string) string {
+	return method + " " + path
+}
+
+// Register adds documentation for routes. Registering the same method and
+// path twice replaces the earlier entry.
+func Register(ops ...Operation) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, op := range ops {
+		operations[key(op.Method, op.Path)] = op
+	}
+}
+
+// documentable reports the method and version-relative path of a registered
+// route under prefix, or the path of a documented root route, skipping
+// middleware and the HEAD routes fiber adds for every GET.
+func documentable(r fiber.Route, prefix string) (method, path string, ok bool) {
+	if r.Method == fiber.MethodHead || r.Method == fiber.MethodConnect || r.Method == fiber.MethodTrace || r.Method == fiber.MethodOptions {
+		return "", "", false
+	}
+	if !strings.HasPrefix(r.Path, prefix+"/") {
+		// Outside the prefix only routes registered as root routes count
+		op, found := operations[key(r.Method, r.Path)]
+		return r.Method, r.Path, found && op.Root
+	}
+	// Middleware mounted with Use has no handler of its own for the method
+	if r.Path == prefix || strings.HasSuffix(r.Path, "*") {
+		return "", "", false
+	}
+	return r.Method, strings.TrimPrefix(r.Path, prefix), true
+}
+
+// Undocumented returns "METHOD /path" for every route under prefix that has
+// no registered Operation.
+func Undocumented(routes []fiber.Route, prefix string) []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	seen := map[string]bool{}
+	var missing []string
+	for _, r := range routes {
+		method, path, ok := documentable(r, prefix)
+		if !ok {
+			continue
+		}
+		k := key(method, path)
+		if _, documented := operations[k]; !documented && !seen[k] {
+			missing = append(missing, k)
+		}
+		seen[k] = true
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+var pathParam = regexp.MustCompile(`:([A-Za-z0-9_]+)\??`)
+
+// Build generates the OpenAPI 3 document for the routes registered under
+// prefix. Only routes that exist and are documented appear in it.
+func Build(routes []fiber.Route, prefix, title, version string) map[string]any {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	components := schemas{}
+	components["Error"] = errorSchema()
+
+	paths := map[string]map[string]any{}
+	for _, r := range routes {
+		method, path, ok := documentable(r, prefix)
+		if !ok {
+			continue
+		}
+		op, documented := operations[key(method, path)]
+		if !documented {
+			continue
+		}
+
+		oaPath := pathParam.ReplaceAllString(path, "{$1}")
+		if paths[oaPath] == nil {
+			paths[oaPath] = map[string]any{}
+		}
+		if op.Root {
+			paths[oaPath]["servers"] = []map[string]any{{"url": "/"}}
+		}
+		paths[oaPath][strings.ToLower(method)] = buildOperation(op, path, components)
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   title,
+			"version": version,
+		},
+		"servers": []map[string]any{{"url": prefix}},
+		"paths":   paths,
+		"components": map[string]any{
+			"schemas": components,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+	}
+}
+
+func buildOperation(op Operation, path string, components schemas) map[string]any {
+	out := map[string]any{
+		"summary":     op.Summary,
+		"operationId": operationID(op.Method, path),
+	}
+	if op.Tag != "" {
+		out["tags"] = []string{op.Tag}
+	}
+	if op.Auth {
+		out["security"] = []map[string][]string{{"bearerAuth": {}}}
+	}
+
+	var params []map[string]any
+	errorStatuses := map[int]bool{fiber.StatusInternalServerError: true}
+	for _, m := range pathParam.FindAllStringSubmatch(path, -1) {
+		params = append(params, map[string]any{
+			"name": m[1], "in": "path", "required": true, "schema": &Schema{Type: "string"},
+		})
+		errorStatuses[fiber.StatusBadRequest] = true
+		errorStatuses[fiber.StatusNotFound] = true
+	}
+	for _, p := range op.Params {
+		in := p.In
+		if in == "" {
+			in = "query"
+		}
+		typ := p.Type
+		if typ == "" {
+			typ = "string"
+		}
+		params = append(params, map[string]any{
+			"name": p.Name, "in": in, "description": p.Description, "schema": &Schema{Type: typ, Enum: p.Enum},
+		})
+		errorStatuses[fiber.StatusBadRequest] = true
+	}
+	if params != nil {
+		out["parameters"] = params
+	}
+
+	if op.Request != nil {
+		out["requestBody"] = map[string]any{
+			"required": true,
+			"content":  map[string]any{fiber.MIMEApplicationJSON: map[string]any{"schema": components.of(op.Request)}},
+		}
+		errorStatuses[fiber.StatusBadRequest] = true
+		errorStatuses[fiber.StatusUnprocessableEntity] = true
+	}
+	if op.Auth {
+		errorStatuses[fiber.StatusUnauthorized] = true
+	}
+	for _, status := range op.Errors {
+		errorStatuses[status] = true
+	}
+
+	success := components.of(op.Response)
+	if !op.Raw {
+		success = envelope(success)
+	}
+	contentType := op.ContentType
+	if contentType == "" {
+		contentType = fiber.MIMEApplicationJSON
+	}
+	responses := map[string]any{
+		"200": map[string]any{
+			"description": "Success",
+			"content":     map[string]any{contentType: map[string]any{"schema": success}},
+		},
+	}
+	if op.Redirect {
+		responses = map[string]any{
+			"302": map[string]any{
+				"description": "Redirect",
+				"headers":     map[string]any{fiber.HeaderLocation: map[string]any{"schema": &Schema{Type: "string"}}},
+			},
+		}
+	}
+	for status := range errorStatuses {
+		responses[strconv.Itoa(status)] = map[string]any{
+			"description": http.StatusText(status),
+			"content": map[string]any{fiber.MIMEApplicationJSON: map[string]any{
+				"schema": &Schema{Ref: "#/components/schemas/Error"},
+			}},
+		}
+	}
+	out["responses"] = responses
+	return out
+}
+
+// envelope wraps data in the util.ResponseAPI success body.
+func envelope(data *Schema) *Schema {
+	data.Nullable = data.Ref == ""
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"status":  {Type: "integer"},
+			"message": {Type: "string"},
+			"data":    data,
+			"token":   {Type: "string", Description: "Only on authentication responses"},
+		},
+		Required: []string{"status", "message", "data"},
+	}
+}
+
+// errorSchema describes the util.ErrorAPI envelope.
+func errorSchema() *Schema {
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"status":  {Type: "integer"},
+			"message": {Type: "string"},
+			"error": {
+				Type: "object",
+				Properties: map[string]*Schema{
+					"code":       {Type: "string", Description: "Stable machine-readable error code"},
+					"details":    {Description: "Field errors or the offending values, when present"},
+					"request_id": {Type: "string"},
+				},
+				Required: []string{"code"},
+			},
+		},
+		Required: []string{"status", "message", "error"},
+	}
+}
+
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == '-' }) {
+		if strings.HasPrefix(part, ":") {
+			b.WriteString("By")
+			part = strings.TrimSuffix(part[1:], "?")
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
@@ -1,17 +1,45 @@
 package route
 
 import (
+	"github.com/MishraShardendu22/cache"
 	"github.com/MishraShardendu22/controller"
+	"github.com/MishraShardendu22/database"
 	"github.com/MishraShardendu22/middleware"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/openapi"
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupAdminRoutes(app *fiber.App, adminPass string, jwtSecret string) {
-	api := app.Group("/api")
-	
+func SetupAdminRoutes(api fiber.Router, adminPass string, jwtSecret string) {
 	api.Post("/admin/auth", func(c *fiber.Ctx) error {
 		return controller.AdminRegisterAndLogin(c, adminPass, jwtSecret)
 	})
 
 	api.Get("/admin/auth",middleware.JWTMiddleware(jwtSecret) ,controller.AdminGet)
+
+	api.Get("/admin/integrity", middleware.JWTMiddleware(jwtSecret), controller.AdminIntegrityCheck)
+	api.Post("/admin/integrity/repair", middleware.JWTMiddleware(jwtSecret), controller.AdminIntegrityRepair)
+	api.Get("/admin/indexes", middleware.JWTMiddleware(jwtSecret), controller.AdminIndexStats)
+
+	api.Get("/admin/cache", middleware.JWTMiddleware(jwtSecret), controller.AdminCacheStats)
+	api.Delete("/admin/cache", middleware.JWTMiddleware(jwtSecret), controller.AdminCacheFlush)
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodPost, Path: "/admin/auth", Tag: "Admin", Summary: "Register the admin or log in",
+			Request: models.AdminLoginRequest{}, Response: models.UserResponse{}, Errors: []int{fiber.StatusUnauthorized}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/auth", Tag: "Admin", Summary: "Profile of the logged in admin",
+			Auth: true, Response: models.UserResponse{}, Errors: []int{fiber.StatusNotFound}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/integrity", Tag: "Admin", Summary: "Check reference integrity",
+			Auth: true, Response: database.IntegrityReport{}},
+		openapi.Operation{Method: fiber.MethodPost, Path: "/admin/integrity/repair", Tag: "Admin", Summary: "Repair reference integrity",
+			Auth: true, Params: []openapi.Param{{Name: "dry_run", Type: "boolean", Description: "Report without writing"}},
+			Response: database.IntegrityReport{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/indexes", Tag: "Admin", Summary: "Index usage per collection",
+			Auth: true, Response: []database.CollectionIndexes{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/cache", Tag: "Admin", Summary: "Read cache statistics",
+			Auth: true, Response: cache.Stats{}},
+		openapi.Operation{Method: fiber.MethodDelete, Path: "/admin/cache", Tag: "Admin", Summary: "Flush the read cache",
+			Auth: true, Params: []openapi.Param{{Name: "tag", Description: "Only drop entries with this tag"}},
+			Response: map[string]int{}},
+	)
 }
@@ -0,0 +1,50 @@
+package route
+
+import (
+	"github.com/MishraShardendu22/analytics"
+	"github.com/MishraShardendu22/controller"
+	"github.com/MishraShardendu22/middleware"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/openapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupRedirectRoutes mounts the public click redirect. It belongs on the app
+// root, outside the versioned API, so that links stay short and stable.
+func SetupRedirectRoutes(app *fiber.App) {
+	// Limited to the URLs stored on the content
+	app.Get("/r/:entity/:id/:field", controller.RedirectLink)
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/r/:entity/:id/:field", Tag: "Analytics",
+			Summary:  "Record a click and redirect to the URL in field: project_live_link, project_repository or project_video of a project, certificate_url of an experience or certification",
+			Redirect: true, Root: true},
+	)
+}
+
+func SetupAnalyticsRoutes(router fiber.Router, secret string) {
+	// Admin routes - authentication required
+	router.Get("/admin/analytics/top", middleware.JWTMiddleware(secret), controller.AdminTopContent)
+	router.Get("/admin/analytics/trends", middleware.JWTMiddleware(secret), controller.AdminViewTrends)
+	router.Get("/admin/analytics/referrers", middleware.JWTMiddleware(secret), controller.AdminReferrers)
+	router.Get("/admin/analytics/links", middleware.JWTMiddleware(secret), controller.AdminLinkClicks)
+
+	days := openapi.Param{Name: "days", Type: "integer", Description: "Days to cover, ending today (UTC), 1-365 (default 30)"}
+	entity := openapi.Param{Name: "entity", Description: "Only this content type",
+		Enum: []string{models.ViewProject, models.ViewExperience, models.ViewCertification}}
+	id := openapi.Param{Name: "id", Description: "Only this piece of content; requires entity"}
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/analytics/top", Tag: "Analytics", Summary: "Most visited content",
+			Auth: true, Params: []openapi.Param{days, entity, {Name: "limit", Type: "integer", Description: "1-100 (default 10)"}},
+			Response: []analytics.TopContent{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/analytics/trends", Tag: "Analytics", Summary: "Views and visitors per day",
+			Auth: true, Params: []openapi.Param{days, entity, id}, Response: []analytics.DayCount{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/analytics/referrers", Tag: "Analytics", Summary: "Sites that sent the most visitors",
+			Auth: true, Params: []openapi.Param{days, entity, id, {Name: "limit", Type: "integer", Description: "1-100 (default 20)"}},
+			Response: []analytics.ReferrerCount{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/analytics/links", Tag: "Analytics", Summary: "Most clicked outbound links",
+			Auth: true, Params: []openapi.Param{days, entity, id, {Name: "limit", Type: "integer", Description: "1-100 (default 20)"}},
+			Response: []analytics.LinkClicks{}},
+	)
+}
@@ -0,0 +1,27 @@
+package route
+
+import (
+	"github.com/MishraShardendu22/openapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Parameters shared by several routes' documentation.
+var (
+	fieldsParam  = openapi.Param{Name: "fields", Description: "Comma-separated JSON fields to return"}
+	expandParam  = openapi.Param{Name: "expand", Description: "Embed related documents", Enum: []string{"projects"}}
+	ifMatchParam = openapi.Param{Name: "If-Match", In: "header", Description: `Expected version ETag, e.g. "v3"`}
+	policyParam  = openapi.Param{Name: "policy", Description: "Override DELETE_POLICY", Enum: []string{"cascade", "restrict"}}
+)
+
+// SetupV1 registers the v1 content and admin routes on router. main mounts
+// it at /api/v1 and, for clients that predate versioning, at /api.
+func SetupV1(router fiber.Router, adminPass string, jwtSecret string) {
+	SetupExpRoutes(router, jwtSecret)
+	SetupSkillRoutes(router, jwtSecret)
+	SetupProjectRoutes(router, jwtSecret)
+	SetupCertificationRoutes(router, jwtSecret)
+	SetupAdminRoutes(router, adminPass, jwtSecret)
+	SetupWebhookRoutes(router, jwtSecret)
+	SetupAnalyticsRoutes(router, jwtSecret)
+	SetupContactRoutes(router, jwtSecret)
+}
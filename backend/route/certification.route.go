@@ -1,18 +1,45 @@
 package route
 
 import (
+	"github.com/MishraShardendu22/cache"
 	"github.com/MishraShardendu22/controller"
 	"github.com/MishraShardendu22/middleware"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/openapi"
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupCertificationRoutes(app *fiber.App, secret string) {
+func SetupCertificationRoutes(router fiber.Router, secret string) {
+	invalidate := middleware.InvalidateCache(cache.TagCertifications, cache.TagRelations)
+
 	// Public routes - no authentication required
-	app.Get("/api/certifications", controller.GetCertifications)
-	app.Get("/api/certifications/:id", controller.GetCertificationByID)
+	router.Get("/certifications", middleware.ReadCache(cache.TagCertifications), controller.GetCertifications)
+	router.Get("/certifications/:id", middleware.TrackView(models.ViewCertification), middleware.ReadCache(cache.TagCertifications), controller.GetCertificationByID)
 
 	// Admin routes - authentication required
-	app.Post("/api/certifications", middleware.JWTMiddleware(secret), controller.AddCertification)
-	app.Put("/api/certifications/:id", middleware.JWTMiddleware(secret), controller.UpdateCertification)
-	app.Delete("/api/certifications/:id", middleware.JWTMiddleware(secret), controller.RemoveCertification)
+	router.Get("/admin/certifications/expiring", middleware.JWTMiddleware(secret), controller.GetExpiringCertifications)
+	router.Post("/certifications", middleware.JWTMiddleware(secret), invalidate, controller.AddCertification)
+	router.Put("/certifications/:id", middleware.JWTMiddleware(secret), invalidate, controller.UpdateCertification)
+	router.Delete("/certifications/:id", middleware.JWTMiddleware(secret), invalidate, controller.RemoveCertification)
+
+	statusParam := openapi.Param{Name: "status", Description: "Only certifications with this expiry status",
+		Enum: []string{models.CertificationActive, models.CertificationExpiringSoon, models.CertificationExpired, models.CertificationNoExpiry}}
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/certifications", Tag: "Certifications", Summary: "List certifications",
+			Params: []openapi.Param{expandParam, fieldsParam, statusParam}, Response: []models.CertificationResponse{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/certifications/:id", Tag: "Certifications", Summary: "Get a certification",
+			Params: []openapi.Param{expandParam, fieldsParam}, Response: models.CertificationResponse{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/certifications/expiring", Tag: "Certifications", Summary: "Certifications expiring soon",
+			Auth: true, Params: []openapi.Param{{Name: "days", Type: "integer", Description: "Window in days (default CERT_EXPIRY_WINDOW_DAYS)"}},
+			Response: []models.CertificationResponse{}},
+		openapi.Operation{Method: fiber.MethodPost, Path: "/certifications", Tag: "Certifications", Summary: "Create a certification",
+			Auth: true, Request: models.CertificationRequest{}, Response: models.CertificationResponse{}, Errors: []int{fiber.StatusNotFound}},
+		openapi.Operation{Method: fiber.MethodPut, Path: "/certifications/:id", Tag: "Certifications", Summary: "Update a certification",
+			Auth: true, Params: []openapi.Param{ifMatchParam}, Request: models.CertificationRequest{}, Response: models.CertificationResponse{},
+			Errors: []int{fiber.StatusPreconditionFailed}},
+		openapi.Operation{Method: fiber.MethodDelete, Path: "/certifications/:id", Tag: "Certifications", Summary: "Delete a certification",
+			Auth: true, Params: []openapi.Param{ifMatchParam, policyParam},
+			Errors: []int{fiber.StatusConflict, fiber.StatusPreconditionFailed}},
+	)
 }
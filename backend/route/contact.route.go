@@ -0,0 +1,54 @@
+package route
+
+import (
+	"time"
+
+	"github.com/MishraShardendu22/controller"
+	"github.com/MishraShardendu22/middleware"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/openapi"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// contactRateLimit allows a few messages per visitor an hour. It is shared
+// by every mount of the routes, so the legacy /api path does not double the
+// allowance.
+var contactRateLimit = limiter.New(limiter.Config{
+	Max:        5,
+	Expiration: time.Hour,
+	// Fixing a validation error does not use up an attempt
+	SkipFailedRequests: true,
+	LimitReached: func(c *fiber.Ctx) error {
+		return util.ErrorAPI(c, fiber.StatusTooManyRequests, util.CodeRateLimited, "Too many messages, try again later", nil)
+	},
+})
+
+func SetupContactRoutes(router fiber.Router, secret string) {
+	// Public route - rate limited per visitor
+	router.Post("/contact", contactRateLimit, controller.AddContactMessage)
+
+	// Admin routes - authentication required
+	router.Get("/admin/contact", middleware.JWTMiddleware(secret), controller.GetContactMessages)
+	router.Get("/admin/contact/:id", middleware.JWTMiddleware(secret), controller.GetContactMessage)
+	router.Patch("/admin/contact/:id", middleware.JWTMiddleware(secret), controller.UpdateContactMessage)
+	router.Delete("/admin/contact/:id", middleware.JWTMiddleware(secret), controller.RemoveContactMessage)
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodPost, Path: "/contact", Tag: "Contact", Summary: "Send a message through the contact form",
+			Request: models.ContactRequest{}, Errors: []int{fiber.StatusTooManyRequests}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/contact", Tag: "Contact", Summary: "List contact messages, newest first",
+			Auth: true, Params: []openapi.Param{
+				{Name: "status", Description: "Which messages to list (default inbox)", Enum: []string{"inbox", "new", "handled", "archived", "spam", "all"}},
+				{Name: "limit", Type: "integer", Description: "Maximum messages to return, 1-200 (default 50)"},
+			},
+			Response: []models.ContactMessageResponse{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/contact/:id", Tag: "Contact", Summary: "Read a contact message, marking it read",
+			Auth: true, Response: models.ContactMessageResponse{}},
+		openapi.Operation{Method: fiber.MethodPatch, Path: "/admin/contact/:id", Tag: "Contact", Summary: "Mark a contact message handled, archived or spam",
+			Auth: true, Request: models.ContactUpdateRequest{}, Response: models.ContactMessageResponse{}},
+		openapi.Operation{Method: fiber.MethodDelete, Path: "/admin/contact/:id", Tag: "Contact", Summary: "Delete a contact message",
+			Auth: true},
+	)
+}
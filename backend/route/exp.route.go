@@ -1,18 +1,38 @@
 package route
 
 import (
+	"github.com/MishraShardendu22/cache"
 	"github.com/MishraShardendu22/controller"
 	"github.com/MishraShardendu22/middleware"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/openapi"
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupExpRoutes(app *fiber.App, secret string) {
+func SetupExpRoutes(router fiber.Router, secret string) {
+	invalidate := middleware.InvalidateCache(cache.TagExperiences, cache.TagRelations)
+
 	// Public routes - no authentication required
-	app.Get("/api/experiences", controller.GetExperiences)
-	app.Get("/api/experiences/:id", controller.GetExperienceByID)
+	router.Get("/experiences", middleware.ReadCache(cache.TagExperiences), controller.GetExperiences)
+	router.Get("/experiences/:id", middleware.TrackView(models.ViewExperience), middleware.ReadCache(cache.TagExperiences), controller.GetExperienceByID)
 
 	// Admin routes - authentication required
-	app.Post("/api/experiences", middleware.JWTMiddleware(secret), controller.AddExperiences)
-	app.Put("/api/experiences/:id", middleware.JWTMiddleware(secret), controller.UpdateExperiences)
-	app.Delete("/api/experiences/:id", middleware.JWTMiddleware(secret), controller.RemoveExperiences)
+	router.Post("/experiences", middleware.JWTMiddleware(secret), invalidate, controller.AddExperiences)
+	router.Put("/experiences/:id", middleware.JWTMiddleware(secret), invalidate, controller.UpdateExperiences)
+	router.Delete("/experiences/:id", middleware.JWTMiddleware(secret), invalidate, controller.RemoveExperiences)
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/experiences", Tag: "Experiences", Summary: "List experiences",
+			Params: []openapi.Param{expandParam, fieldsParam}, Response: []models.ExperienceResponse{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/experiences/:id", Tag: "Experiences", Summary: "Get an experience",
+			Params: []openapi.Param{expandParam, fieldsParam}, Response: models.ExperienceResponse{}},
+		openapi.Operation{Method: fiber.MethodPost, Path: "/experiences", Tag: "Experiences", Summary: "Create an experience",
+			Auth: true, Request: models.ExperienceRequest{}, Response: models.ExperienceResponse{}, Errors: []int{fiber.StatusNotFound}},
+		openapi.Operation{Method: fiber.MethodPut, Path: "/experiences/:id", Tag: "Experiences", Summary: "Update an experience",
+			Auth: true, Params: []openapi.Param{ifMatchParam}, Request: models.ExperienceRequest{}, Response: models.ExperienceResponse{},
+			Errors: []int{fiber.StatusPreconditionFailed}},
+		openapi.Operation{Method: fiber.MethodDelete, Path: "/experiences/:id", Tag: "Experiences", Summary: "Delete an experience",
+			Auth: true, Params: []openapi.Param{ifMatchParam, policyParam},
+			Errors: []int{fiber.StatusConflict, fiber.StatusPreconditionFailed}},
+	)
 }
@@ -0,0 +1,33 @@
+package route
+
+import (
+	"fmt"
+
+	"github.com/MishraShardendu22/graph"
+	"github.com/MishraShardendu22/openapi"
+	"github.com/gofiber/fiber/v2"
+	"github.com/graphql-go/graphql"
+)
+
+func SetupGraphRoutes(router fiber.Router, opts graph.Options) error {
+	// Public, read-only - the schema has no mutations
+	handler, err := graph.Handler(opts)
+	if err != nil {
+		return fmt.Errorf("graphql schema: %w", err)
+	}
+	router.Get("/graphql", handler)
+	router.Post("/graphql", handler)
+
+	queryParams := []openapi.Param{
+		{Name: "query", Description: "GraphQL query document"},
+		{Name: "operationName", Description: "Operation to run when the document has several"},
+		{Name: "variables", Description: "JSON-encoded variables"},
+	}
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/graphql", Tag: "GraphQL", Summary: "Run a read-only GraphQL query",
+			Params: queryParams, Raw: true, Response: graphql.Result{}, Errors: []int{fiber.StatusUnprocessableEntity}},
+		openapi.Operation{Method: fiber.MethodPost, Path: "/graphql", Tag: "GraphQL", Summary: "Run a read-only GraphQL query",
+			Request: graph.Request{}, Raw: true, Response: graphql.Result{}},
+	)
+	return nil
+}
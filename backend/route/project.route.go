@@ -1,18 +1,43 @@
 package route
 
 import (
+	"github.com/MishraShardendu22/cache"
 	"github.com/MishraShardendu22/controller"
 	"github.com/MishraShardendu22/middleware"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/openapi"
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupProjectRoutes(app *fiber.App, secret string) {
+func SetupProjectRoutes(router fiber.Router, secret string) {
+	// Project changes show up in skills, expanded experiences/certifications and relations
+	invalidate := middleware.InvalidateCache(cache.TagProjects, cache.TagSkills, cache.TagExperiences, cache.TagCertifications, cache.TagRelations)
+
 	// Public routes - no authentication required
-	app.Get("/api/projects", controller.GetProjects)
-	app.Get("/api/projects/:id", controller.GetProjectByID)
+	router.Get("/projects", middleware.ReadCache(cache.TagProjects), controller.GetProjects)
+	router.Get("/projects/:id", middleware.TrackView(models.ViewProject), middleware.ReadCache(cache.TagProjects), controller.GetProjectByID)
+	router.Get("/projects/:id/related", middleware.ReadCache(cache.TagRelations), controller.GetProjectRelations)
 
 	// Admin routes - authentication required
-	app.Post("/api/projects", middleware.JWTMiddleware(secret), controller.AddProjects)
-	app.Put("/api/projects/:id", middleware.JWTMiddleware(secret), controller.UpdateProjects)
-	app.Delete("/api/projects/:id", middleware.JWTMiddleware(secret), controller.RemoveProjects)
+	router.Post("/projects", middleware.JWTMiddleware(secret), invalidate, controller.AddProjects)
+	router.Put("/projects/:id", middleware.JWTMiddleware(secret), invalidate, controller.UpdateProjects)
+	router.Delete("/projects/:id", middleware.JWTMiddleware(secret), invalidate, controller.RemoveProjects)
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/projects", Tag: "Projects", Summary: "List projects",
+			Params: []openapi.Param{fieldsParam}, Response: []models.ProjectResponse{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/projects/:id", Tag: "Projects", Summary: "Get a project",
+			Params: []openapi.Param{fieldsParam}, Response: models.ProjectResponse{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/projects/:id/related", Tag: "Projects", Summary: "Content related to a project",
+			Params: []openapi.Param{{Name: "limit", Type: "integer", Description: "Similar projects to return, 0 to 50 (default 5)"}},
+			Response: controller.ProjectRelations{}},
+		openapi.Operation{Method: fiber.MethodPost, Path: "/projects", Tag: "Projects", Summary: "Create a project",
+			Auth: true, Request: models.ProjectRequest{}, Response: models.ProjectResponse{}},
+		openapi.Operation{Method: fiber.MethodPut, Path: "/projects/:id", Tag: "Projects", Summary: "Update a project",
+			Auth: true, Params: []openapi.Param{ifMatchParam}, Request: models.ProjectRequest{}, Response: models.ProjectResponse{},
+			Errors: []int{fiber.StatusPreconditionFailed}},
+		openapi.Operation{Method: fiber.MethodDelete, Path: "/projects/:id", Tag: "Projects", Summary: "Delete a project",
+			Auth: true, Params: []openapi.Param{ifMatchParam, policyParam},
+			Errors: []int{fiber.StatusConflict, fiber.StatusPreconditionFailed}},
+	)
 }
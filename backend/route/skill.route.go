@@ -1,15 +1,25 @@
 package route
 
 import (
+	"github.com/MishraShardendu22/cache"
 	"github.com/MishraShardendu22/controller"
 	"github.com/MishraShardendu22/middleware"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/openapi"
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupSkillRoutes(app *fiber.App, secret string) {
+func SetupSkillRoutes(router fiber.Router, secret string) {
 	// Public routes - no authentication required
-	app.Get("/api/skills", controller.GetSkills)
+	router.Get("/skills", middleware.ReadCache(cache.TagSkills), controller.GetSkills)
 
 	// Admin routes - authentication required
-	app.Post("/api/skills", middleware.JWTMiddleware(secret), controller.AddSkills)
+	router.Post("/skills", middleware.JWTMiddleware(secret), middleware.InvalidateCache(cache.TagSkills), controller.AddSkills)
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/skills", Tag: "Skills", Summary: "Skills used across projects",
+			Response: []string{}, Errors: []int{fiber.StatusNotFound}},
+		openapi.Operation{Method: fiber.MethodPost, Path: "/skills", Tag: "Skills", Summary: "Add skills to the profile",
+			Auth: true, Request: models.SkillsRequest{}, Response: []string{}, Errors: []int{fiber.StatusNotFound}},
+	)
 }
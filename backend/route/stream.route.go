@@ -0,0 +1,23 @@
+package route
+
+import (
+	"github.com/MishraShardendu22/events"
+	"github.com/MishraShardendu22/openapi"
+	"github.com/MishraShardendu22/stream"
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupStreamRoutes(router fiber.Router, opts stream.Options) {
+	// Public - events carry the same data as the public read routes
+	router.Get("/events", stream.Handler(opts))
+
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/events", Tag: "Events", Summary: "Server-Sent Events stream of content changes and stats refreshes",
+			Params: []openapi.Param{
+				{Name: "entities", Description: "Comma-separated entities to receive: project, experience, certification, skills, stats (default all)"},
+				{Name: "Last-Event-ID", In: "header", Description: "Resume after this event ID"},
+				{Name: "last_event_id", Description: "Resume after this event ID, for clients that cannot set headers"},
+			},
+			Raw: true, ContentType: "text/event-stream", Response: events.Event{}},
+	)
+}
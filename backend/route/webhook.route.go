@@ -0,0 +1,48 @@
+package route
+
+import (
+	"github.com/MishraShardendu22/controller"
+	"github.com/MishraShardendu22/middleware"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/openapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupWebhookRoutes(router fiber.Router, secret string) {
+	// Admin routes - authentication required
+	auth := middleware.JWTMiddleware(secret)
+	router.Get("/admin/webhooks", auth, controller.GetWebhooks)
+	router.Post("/admin/webhooks", auth, controller.AddWebhook)
+	router.Get("/admin/webhooks/:id", auth, controller.GetWebhook)
+	router.Put("/admin/webhooks/:id", auth, controller.UpdateWebhook)
+	router.Delete("/admin/webhooks/:id", auth, controller.RemoveWebhook)
+	router.Post("/admin/webhooks/:id/ping", auth, controller.PingWebhook)
+	router.Get("/admin/webhooks/:id/deliveries", auth, controller.GetWebhookDeliveries)
+	router.Get("/admin/webhooks/:id/deliveries/:deliveryId", auth, controller.GetWebhookDelivery)
+	router.Post("/admin/webhooks/:id/deliveries/:deliveryId/redeliver", auth, controller.RedeliverWebhook)
+
+	deliveryParams := []openapi.Param{
+		{Name: "status", Description: "Only deliveries with this status", Enum: []string{models.DeliveryPending, models.DeliverySucceeded, models.DeliveryFailed}},
+		{Name: "limit", Type: "integer", Description: "Maximum deliveries to return, 1-200 (default 50)"},
+	}
+	openapi.Register(
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/webhooks", Tag: "Webhooks", Summary: "List webhook subscriptions",
+			Auth: true, Response: []models.WebhookSubscriptionResponse{}},
+		openapi.Operation{Method: fiber.MethodPost, Path: "/admin/webhooks", Tag: "Webhooks", Summary: "Add a webhook subscription; the response includes its signing secret",
+			Auth: true, Request: models.WebhookRequest{}, Response: models.WebhookSubscriptionResponse{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/webhooks/:id", Tag: "Webhooks", Summary: "Get a webhook subscription",
+			Auth: true, Response: models.WebhookSubscriptionResponse{}},
+		openapi.Operation{Method: fiber.MethodPut, Path: "/admin/webhooks/:id", Tag: "Webhooks", Summary: "Update a webhook subscription",
+			Auth: true, Request: models.WebhookRequest{}, Response: models.WebhookSubscriptionResponse{}},
+		openapi.Operation{Method: fiber.MethodDelete, Path: "/admin/webhooks/:id", Tag: "Webhooks", Summary: "Delete a webhook subscription and cancel its pending deliveries",
+			Auth: true},
+		openapi.Operation{Method: fiber.MethodPost, Path: "/admin/webhooks/:id/ping", Tag: "Webhooks", Summary: "Send a webhook.ping test event",
+			Auth: true, Response: models.WebhookDeliveryResponse{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/webhooks/:id/deliveries", Tag: "Webhooks", Summary: "Delivery log, newest first",
+			Auth: true, Params: deliveryParams, Response: []models.WebhookDeliveryResponse{}},
+		openapi.Operation{Method: fiber.MethodGet, Path: "/admin/webhooks/:id/deliveries/:deliveryId", Tag: "Webhooks", Summary: "Get a delivery",
+			Auth: true, Response: models.WebhookDeliveryResponse{}},
+		openapi.Operation{Method: fiber.MethodPost, Path: "/admin/webhooks/:id/deliveries/:deliveryId/redeliver", Tag: "Webhooks", Summary: "Send a delivery's event again as a new delivery",
+			Auth: true, Response: models.WebhookDeliveryResponse{}},
+	)
+}
@@ -0,0 +1,182 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MishraShardendu22/database"
+	"github.com/MishraShardendu22/events"
+	"github.com/MishraShardendu22/rpc/pb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchBuffer is how many events a WatchContent stream may fall behind
+// before it is ended.
+const watchBuffer = 64
+
+type contentService struct {
+	pb.UnimplementedPortfolioServiceServer
+	opts Options
+}
+
+func (s *contentService) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	filter := bson.M{}
+	if req.GetSkill() != "" {
+		filter["skills"] = req.GetSkill()
+	}
+
+	projects, err := database.Projects(ctx, filter)
+	if err != nil {
+		return nil, s.internal("fetch projects", err)
+	}
+
+	resp := &pb.ListProjectsResponse{Projects: make([]*pb.Project, len(projects))}
+	for i, p := range projects {
+		resp.Projects[i] = projectMessage(p.Response())
+	}
+	return resp, nil
+}
+
+func (s *contentService) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.Project, error) {
+	id, err := parseID(req.GetId(), "project")
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := database.ProjectByID(ctx, id)
+	if err != nil {
+		return nil, s.lookupError("Project", err)
+	}
+	return projectMessage(p.Response()), nil
+}
+
+func (s *contentService) ListExperiences(ctx context.Context, _ *pb.ListExperiencesRequest) (*pb.ListExperiencesResponse, error) {
+	exps, err := database.Experiences(ctx)
+	if err != nil {
+		return nil, s.internal("fetch experiences", err)
+	}
+
+	resp := &pb.ListExperiencesResponse{Experiences: make([]*pb.Experience, len(exps))}
+	for i, e := range exps {
+		resp.Experiences[i] = experienceMessage(e.Response())
+	}
+	return resp, nil
+}
+
+func (s *contentService) GetExperience(ctx context.Context, req *pb.GetExperienceRequest) (*pb.Experience, error) {
+	id, err := parseID(req.GetId(), "experience")
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := database.ExperienceByID(ctx, id)
+	if err != nil {
+		return nil, s.lookupError("Experience", err)
+	}
+	return experienceMessage(e.Response()), nil
+}
+
+func (s *contentService) ListCertifications(ctx context.Context, req *pb.ListCertificationsRequest) (*pb.ListCertificationsResponse, error) {
+	want, ok := statusName(req.GetStatus())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Invalid status")
+	}
+
+	certs, err := database.Certifications(ctx, time.Now(), s.opts.ExpiryWindowDays, want)
+	if err != nil {
+		return nil, s.internal("fetch certifications", err)
+	}
+
+	resp := &pb.ListCertificationsResponse{Certifications: make([]*pb.Certification, len(certs))}
+	for i, c := range certs {
+		resp.Certifications[i] = certificationMessage(c.Response())
+	}
+	return resp, nil
+}
+
+func (s *contentService) GetCertification(ctx context.Context, req *pb.GetCertificationRequest) (*pb.Certification, error) {
+	id, err := parseID(req.GetId(), "certification")
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := database.CertificationByID(ctx, id, time.Now(), s.opts.ExpiryWindowDays)
+	if err != nil {
+		return nil, s.lookupError("Certification", err)
+	}
+	return certificationMessage(c.Response()), nil
+}
+
+func (s *contentService) ListSkills(ctx context.Context, _ *pb.ListSkillsRequest) (*pb.ListSkillsResponse, error) {
+	filter, err := database.SkillsFilter(ctx)
+	if errors.Is(err, database.ErrNoUser) {
+		return nil, status.Error(codes.NotFound, "User not found")
+	} else if err != nil {
+		return nil, s.internal("fetch user", err)
+	}
+
+	skills, err := database.Skills(ctx, filter)
+	if err != nil {
+		return nil, s.internal("fetch skills", err)
+	}
+	return &pb.ListSkillsResponse{Skills: skills}, nil
+}
+
+func (s *contentService) WatchContent(req *pb.WatchContentRequest, stream pb.PortfolioService_WatchContentServer) error {
+	watched := map[string]bool{}
+	for _, entity := range req.GetEntities() {
+		switch entity {
+		case events.Project, events.Experience, events.Certification, events.Skills:
+			watched[entity] = true
+		default:
+			return status.Errorf(codes.InvalidArgument, "Unknown entity %q, expected project, experience, certification or skills", entity)
+		}
+	}
+
+	ch, unsubscribe := s.opts.Bus.Subscribe(watchBuffer)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, open := <-ch:
+			if !open {
+				return status.Error(codes.ResourceExhausted, "Stream fell too far behind, reconnect and list again")
+			}
+			if len(watched) > 0 && !watched[e.Entity] {
+				continue
+			}
+			if err := stream.Send(eventMessage(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func parseID(hex, label string) (primitive.ObjectID, error) {
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return id, status.Errorf(codes.InvalidArgument, "Invalid %s ID", label)
+	}
+	return id, nil
+}
+
+func (s *contentService) lookupError(label string, err error) error {
+	if errors.Is(err, database.ErrNotFound) {
+		return status.Error(codes.NotFound, label+" not found")
+	}
+	return s.internal("fetch "+strings.ToLower(label), err)
+}
+
+// internal logs err and hides it from the client, like the REST handlers'
+// 500 responses.
+func (s *contentService) internal(action string, err error) error {
+	s.opts.Logger.Error("gRPC request failed", "action", action, "error", err)
+	return status.Error(codes.Internal, "Failed to "+action)
+}
@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/MishraShardendu22/events"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/rpc/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Messages are built from the REST response DTOs, which is also what the
+// content events carry.
+
+var statuses = map[string]pb.CertificationStatus{
+	models.CertificationActive:       pb.CertificationStatus_CERTIFICATION_STATUS_ACTIVE,
+	models.CertificationExpiringSoon: pb.CertificationStatus_CERTIFICATION_STATUS_EXPIRING_SOON,
+	models.CertificationExpired:      pb.CertificationStatus_CERTIFICATION_STATUS_EXPIRED,
+	models.CertificationNoExpiry:     pb.CertificationStatus_CERTIFICATION_STATUS_NO_EXPIRY,
+}
+
+// statusName returns the stored status for s, "" for unspecified.
+func statusName(s pb.CertificationStatus) (string, bool) {
+	if s == pb.CertificationStatus_CERTIFICATION_STATUS_UNSPECIFIED {
+		return "", true
+	}
+	for name, status := range statuses {
+		if status == s {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func hexIDs(ids []primitive.ObjectID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.Hex()
+	}
+	return out
+}
+
+func projectMessage(p models.ProjectResponse) *pb.Project {
+	return &pb.Project{
+		Id:                p.ID,
+		ProjectName:       p.ProjectName,
+		SmallDescription:  p.SmallDescription,
+		Description:       p.Description,
+		Skills:            p.Skills,
+		ProjectRepository: p.ProjectRepository,
+		ProjectLiveLink:   p.ProjectLiveLink,
+		ProjectVideo:      p.ProjectVideo,
+		Version:           p.Version,
+		CreatedAt:         timestamp(p.CreatedAt),
+		UpdatedAt:         timestamp(p.UpdatedAt),
+	}
+}
+
+func experienceMessage(e models.ExperienceResponse) *pb.Experience {
+	return &pb.Experience{
+		Id:             e.ID,
+		CompanyName:    e.CompanyName,
+		Position:       e.Position,
+		StartDate:      e.StartDate,
+		EndDate:        e.EndDate,
+		Description:    e.Description,
+		Technologies:   e.Technologies,
+		CreatedBy:      e.CreatedBy,
+		ProjectIds:     hexIDs(e.Projects),
+		CompanyLogo:    e.CompanyLogo,
+		CertificateUrl: e.CertificateURL,
+		Images:         e.Images,
+		Version:        e.Version,
+		CreatedAt:      timestamp(e.CreatedAt),
+		UpdatedAt:      timestamp(e.UpdatedAt),
+	}
+}
+
+func certificationMessage(c models.CertificationResponse) *pb.Certification {
+	return &pb.Certification{
+		Id:             c.ID,
+		Title:          c.Title,
+		Description:    c.Description,
+		ProjectIds:     hexIDs(c.Projects),
+		Skills:         c.Skills,
+		CertificateUrl: c.CertificateURL,
+		Images:         c.Images,
+		Issuer:         c.Issuer,
+		IssueDate:      c.IssueDate,
+		ExpiryDate:     c.ExpiryDate,
+		Status:         statuses[c.Status],
+		Version:        c.Version,
+		CreatedAt:      timestamp(c.CreatedAt),
+		UpdatedAt:      timestamp(c.UpdatedAt),
+	}
+}
+
+func eventMessage(e events.Event) *pb.ContentEvent {
+	msg := &pb.ContentEvent{
+		Id:         e.ID,
+		Type:       e.Type,
+		Entity:     e.Entity,
+		Action:     e.Action,
+		EntityId:   e.EntityID,
+		OccurredAt: timestamp(e.At),
+	}
+
+	switch data := e.Data.(type) {
+	case models.ProjectResponse:
+		msg.Data = &pb.ContentEvent_Project{Project: projectMessage(data)}
+	case models.ExperienceResponse:
+		msg.Data = &pb.ContentEvent_Experience{Experience: experienceMessage(data)}
+	case models.CertificationResponse:
+		msg.Data = &pb.ContentEvent_Certification{Certification: certificationMessage(data)}
+	}
+	return msg
+}
@@ -0,0 +1,1393 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: portfolio.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CertificationStatus int32
+
+const (
+	CertificationStatus_CERTIFICATION_STATUS_UNSPECIFIED   CertificationStatus = 0
+	CertificationStatus_CERTIFICATION_STATUS_ACTIVE        CertificationStatus = 1
+	CertificationStatus_CERTIFICATION_STATUS_EXPIRING_SOON CertificationStatus = 2
+	CertificationStatus_CERTIFICATION_STATUS_EXPIRED       CertificationStatus = 3
+	CertificationStatus_CERTIFICATION_STATUS_NO_EXPIRY     CertificationStatus = 4
+)
+
+// Enum value maps for CertificationStatus.
+var (
+	CertificationStatus_name = map[int32]string{
+		0: "CERTIFICATION_STATUS_UNSPECIFIED",
+		1: "CERTIFICATION_STATUS_ACTIVE",
+		2: "CERTIFICATION_STATUS_EXPIRING_SOON",
+		3: "CERTIFICATION_STATUS_EXPIRED",
+		4: "CERTIFICATION_STATUS_NO_EXPIRY",
+	}
+	CertificationStatus_value = map[string]int32{
+		"CERTIFICATION_STATUS_UNSPECIFIED":   0,
+		"CERTIFICATION_STATUS_ACTIVE":        1,
+		"CERTIFICATION_STATUS_EXPIRING_SOON": 2,
+		"CERTIFICATION_STATUS_EXPIRED":       3,
+		"CERTIFICATION_STATUS_NO_EXPIRY":     4,
+	}
+)
+
+func (x CertificationStatus) Enum() *CertificationStatus {
+	p := new(CertificationStatus)
+	*p = x
+	return p
+}
+
+func (x CertificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CertificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_portfolio_proto_enumTypes[0].Descriptor()
+}
+
+func (CertificationStatus) Type() protoreflect.EnumType {
+	return &file_portfolio_proto_enumTypes[0]
+}
+
+func (x CertificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CertificationStatus.Descriptor instead.
+func (CertificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{0}
+}
+
+type Project struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectName       string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	SmallDescription  string                 `protobuf:"bytes,3,opt,name=small_description,json=smallDescription,proto3" json:"small_description,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Skills            []string               `protobuf:"bytes,5,rep,name=skills,proto3" json:"skills,omitempty"`
+	ProjectRepository string                 `protobuf:"bytes,6,opt,name=project_repository,json=projectRepository,proto3" json:"project_repository,omitempty"`
+	ProjectLiveLink   string                 `protobuf:"bytes,7,opt,name=project_live_link,json=projectLiveLink,proto3" json:"project_live_link,omitempty"`
+	ProjectVideo      string                 `protobuf:"bytes,8,opt,name=project_video,json=projectVideo,proto3" json:"project_video,omitempty"`
+	Version           int64                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_portfolio_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Project) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Project) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *Project) GetSmallDescription() string {
+	if x != nil {
+		return x.SmallDescription
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *Project) GetProjectRepository() string {
+	if x != nil {
+		return x.ProjectRepository
+	}
+	return ""
+}
+
+func (x *Project) GetProjectLiveLink() string {
+	if x != nil {
+		return x.ProjectLiveLink
+	}
+	return ""
+}
+
+func (x *Project) GetProjectVideo() string {
+	if x != nil {
+		return x.ProjectVideo
+	}
+	return ""
+}
+
+func (x *Project) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Experience struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyName    string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	Position       string                 `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	StartDate      string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Technologies   []string               `protobuf:"bytes,7,rep,name=technologies,proto3" json:"technologies,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ProjectIds     []string               `protobuf:"bytes,9,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	CompanyLogo    string                 `protobuf:"bytes,10,opt,name=company_logo,json=companyLogo,proto3" json:"company_logo,omitempty"`
+	CertificateUrl string                 `protobuf:"bytes,11,opt,name=certificate_url,json=certificateUrl,proto3" json:"certificate_url,omitempty"`
+	Images         []string               `protobuf:"bytes,12,rep,name=images,proto3" json:"images,omitempty"`
+	Version        int64                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Experience) Reset() {
+	*x = Experience{}
+	mi := &file_portfolio_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Experience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experience) ProtoMessage() {}
+
+func (x *Experience) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experience.ProtoReflect.Descriptor instead.
+func (*Experience) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Experience) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Experience) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *Experience) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Experience) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Experience) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Experience) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Experience) GetTechnologies() []string {
+	if x != nil {
+		return x.Technologies
+	}
+	return nil
+}
+
+func (x *Experience) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Experience) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+func (x *Experience) GetCompanyLogo() string {
+	if x != nil {
+		return x.CompanyLogo
+	}
+	return ""
+}
+
+func (x *Experience) GetCertificateUrl() string {
+	if x != nil {
+		return x.CertificateUrl
+	}
+	return ""
+}
+
+func (x *Experience) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Experience) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Experience) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Experience) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Certification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ProjectIds     []string               `protobuf:"bytes,4,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	Skills         []string               `protobuf:"bytes,5,rep,name=skills,proto3" json:"skills,omitempty"`
+	CertificateUrl string                 `protobuf:"bytes,6,opt,name=certificate_url,json=certificateUrl,proto3" json:"certificate_url,omitempty"`
+	Images         []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
+	Issuer         string                 `protobuf:"bytes,8,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	IssueDate      string                 `protobuf:"bytes,9,opt,name=issue_date,json=issueDate,proto3" json:"issue_date,omitempty"`
+	ExpiryDate     string                 `protobuf:"bytes,10,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	Status         CertificationStatus    `protobuf:"varint,11,opt,name=status,proto3,enum=portfolio.v1.CertificationStatus" json:"status,omitempty"`
+	Version        int64                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Certification) Reset() {
+	*x = Certification{}
+	mi := &file_portfolio_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Certification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certification) ProtoMessage() {}
+
+func (x *Certification) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certification.ProtoReflect.Descriptor instead.
+func (*Certification) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Certification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Certification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Certification) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Certification) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+func (x *Certification) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *Certification) GetCertificateUrl() string {
+	if x != nil {
+		return x.CertificateUrl
+	}
+	return ""
+}
+
+func (x *Certification) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Certification) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Certification) GetIssueDate() string {
+	if x != nil {
+		return x.IssueDate
+	}
+	return ""
+}
+
+func (x *Certification) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *Certification) GetStatus() CertificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CertificationStatus_CERTIFICATION_STATUS_UNSPECIFIED
+}
+
+func (x *Certification) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Certification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Certification) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only projects using this skill, when set.
+	Skill         string `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_portfolio_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListProjectsRequest) GetSkill() string {
+	if x != nil {
+		return x.Skill
+	}
+	return ""
+}
+
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_portfolio_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_portfolio_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListExperiencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperiencesRequest) Reset() {
+	*x = ListExperiencesRequest{}
+	mi := &file_portfolio_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperiencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperiencesRequest) ProtoMessage() {}
+
+func (x *ListExperiencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperiencesRequest.ProtoReflect.Descriptor instead.
+func (*ListExperiencesRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{6}
+}
+
+type ListExperiencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiences   []*Experience          `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperiencesResponse) Reset() {
+	*x = ListExperiencesResponse{}
+	mi := &file_portfolio_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperiencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperiencesResponse) ProtoMessage() {}
+
+func (x *ListExperiencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperiencesResponse.ProtoReflect.Descriptor instead.
+func (*ListExperiencesResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListExperiencesResponse) GetExperiences() []*Experience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+type GetExperienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExperienceRequest) Reset() {
+	*x = GetExperienceRequest{}
+	mi := &file_portfolio_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExperienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExperienceRequest) ProtoMessage() {}
+
+func (x *GetExperienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExperienceRequest.ProtoReflect.Descriptor instead.
+func (*GetExperienceRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetExperienceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCertificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only certifications with this status, unless unspecified.
+	Status        CertificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=portfolio.v1.CertificationStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCertificationsRequest) Reset() {
+	*x = ListCertificationsRequest{}
+	mi := &file_portfolio_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCertificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertificationsRequest) ProtoMessage() {}
+
+func (x *ListCertificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCertificationsRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCertificationsRequest) GetStatus() CertificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CertificationStatus_CERTIFICATION_STATUS_UNSPECIFIED
+}
+
+type ListCertificationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Certifications []*Certification       `protobuf:"bytes,1,rep,name=certifications,proto3" json:"certifications,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCertificationsResponse) Reset() {
+	*x = ListCertificationsResponse{}
+	mi := &file_portfolio_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCertificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertificationsResponse) ProtoMessage() {}
+
+func (x *ListCertificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListCertificationsResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCertificationsResponse) GetCertifications() []*Certification {
+	if x != nil {
+		return x.Certifications
+	}
+	return nil
+}
+
+type GetCertificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCertificationRequest) Reset() {
+	*x = GetCertificationRequest{}
+	mi := &file_portfolio_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCertificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificationRequest) ProtoMessage() {}
+
+func (x *GetCertificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificationRequest.ProtoReflect.Descriptor instead.
+func (*GetCertificationRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCertificationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkillsRequest) Reset() {
+	*x = ListSkillsRequest{}
+	mi := &file_portfolio_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkillsRequest) ProtoMessage() {}
+
+func (x *ListSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkillsRequest.ProtoReflect.Descriptor instead.
+func (*ListSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{12}
+}
+
+type ListSkillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        []string               `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkillsResponse) Reset() {
+	*x = ListSkillsResponse{}
+	mi := &file_portfolio_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkillsResponse) ProtoMessage() {}
+
+func (x *ListSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkillsResponse.ProtoReflect.Descriptor instead.
+func (*ListSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSkillsResponse) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type WatchContentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Entities to watch: project, experience, certification or skills. Empty
+	// watches all of them.
+	Entities      []string `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchContentRequest) Reset() {
+	*x = WatchContentRequest{}
+	mi := &file_portfolio_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchContentRequest) ProtoMessage() {}
+
+func (x *WatchContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchContentRequest.ProtoReflect.Descriptor instead.
+func (*WatchContentRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchContentRequest) GetEntities() []string {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type ContentEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "<entity>.<action>", e.g. "project.created".
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Entity string `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	// created, updated or deleted.
+	Action     string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	EntityId   string                 `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// The entity after the change. Unset for deletes and skill changes.
+	//
+	// Types that are valid to be assigned to Data:
+	//
+	//	*ContentEvent_Project
+	//	*ContentEvent_Experience
+	//	*ContentEvent_Certification
+	Data          isContentEvent_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentEvent) Reset() {
+	*x = ContentEvent{}
+	mi := &file_portfolio_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentEvent) ProtoMessage() {}
+
+func (x *ContentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentEvent.ProtoReflect.Descriptor instead.
+func (*ContentEvent) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ContentEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ContentEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContentEvent) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ContentEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ContentEvent) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ContentEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *ContentEvent) GetData() isContentEvent_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ContentEvent) GetProject() *Project {
+	if x != nil {
+		if x, ok := x.Data.(*ContentEvent_Project); ok {
+			return x.Project
+		}
+	}
+	return nil
+}
+
+func (x *ContentEvent) GetExperience() *Experience {
+	if x != nil {
+		if x, ok := x.Data.(*ContentEvent_Experience); ok {
+			return x.Experience
+		}
+	}
+	return nil
+}
+
+func (x *ContentEvent) GetCertification() *Certification {
+	if x != nil {
+		if x, ok := x.Data.(*ContentEvent_Certification); ok {
+			return x.Certification
+		}
+	}
+	return nil
+}
+
+type isContentEvent_Data interface {
+	isContentEvent_Data()
+}
+
+type ContentEvent_Project struct {
+	Project *Project `protobuf:"bytes,7,opt,name=project,proto3,oneof"`
+}
+
+type ContentEvent_Experience struct {
+	Experience *Experience `protobuf:"bytes,8,opt,name=experience,proto3,oneof"`
+}
+
+type ContentEvent_Certification struct {
+	Certification *Certification `protobuf:"bytes,9,opt,name=certification,proto3,oneof"`
+}
+
+func (*ContentEvent_Project) isContentEvent_Data() {}
+
+func (*ContentEvent_Experience) isContentEvent_Data() {}
+
+func (*ContentEvent_Certification) isContentEvent_Data() {}
+
+var File_portfolio_proto protoreflect.FileDescriptor
+
+const file_portfolio_proto_rawDesc = "" +
+	"\n" +
+	"\x0fportfolio.proto\x12\fportfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb3\x03\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fproject_name\x18\x02 \x01(\tR\vprojectName\x12+\n" +
+	"\x11small_description\x18\x03 \x01(\tR\x10smallDescription\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06skills\x18\x05 \x03(\tR\x06skills\x12-\n" +
+	"\x12project_repository\x18\x06 \x01(\tR\x11projectRepository\x12*\n" +
+	"\x11project_live_link\x18\a \x01(\tR\x0fprojectLiveLink\x12#\n" +
+	"\rproject_video\x18\b \x01(\tR\fprojectVideo\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8f\x04\n" +
+	"\n" +
+	"Experience\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcompany_name\x18\x02 \x01(\tR\vcompanyName\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\tR\bposition\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\"\n" +
+	"\ftechnologies\x18\a \x03(\tR\ftechnologies\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1f\n" +
+	"\vproject_ids\x18\t \x03(\tR\n" +
+	"projectIds\x12!\n" +
+	"\fcompany_logo\x18\n" +
+	" \x01(\tR\vcompanyLogo\x12'\n" +
+	"\x0fcertificate_url\x18\v \x01(\tR\x0ecertificateUrl\x12\x16\n" +
+	"\x06images\x18\f \x03(\tR\x06images\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf4\x03\n" +
+	"\rCertification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vproject_ids\x18\x04 \x03(\tR\n" +
+	"projectIds\x12\x16\n" +
+	"\x06skills\x18\x05 \x03(\tR\x06skills\x12'\n" +
+	"\x0fcertificate_url\x18\x06 \x01(\tR\x0ecertificateUrl\x12\x16\n" +
+	"\x06images\x18\a \x03(\tR\x06images\x12\x16\n" +
+	"\x06issuer\x18\b \x01(\tR\x06issuer\x12\x1d\n" +
+	"\n" +
+	"issue_date\x18\t \x01(\tR\tissueDate\x12\x1f\n" +
+	"\vexpiry_date\x18\n" +
+	" \x01(\tR\n" +
+	"expiryDate\x129\n" +
+	"\x06status\x18\v \x01(\x0e2!.portfolio.v1.CertificationStatusR\x06status\x12\x18\n" +
+	"\aversion\x18\f \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"+\n" +
+	"\x13ListProjectsRequest\x12\x14\n" +
+	"\x05skill\x18\x01 \x01(\tR\x05skill\"I\n" +
+	"\x14ListProjectsResponse\x121\n" +
+	"\bprojects\x18\x01 \x03(\v2\x15.portfolio.v1.ProjectR\bprojects\"#\n" +
+	"\x11GetProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16ListExperiencesRequest\"U\n" +
+	"\x17ListExperiencesResponse\x12:\n" +
+	"\vexperiences\x18\x01 \x03(\v2\x18.portfolio.v1.ExperienceR\vexperiences\"&\n" +
+	"\x14GetExperienceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x19ListCertificationsRequest\x129\n" +
+	"\x06status\x18\x01 \x01(\x0e2!.portfolio.v1.CertificationStatusR\x06status\"a\n" +
+	"\x1aListCertificationsResponse\x12C\n" +
+	"\x0ecertifications\x18\x01 \x03(\v2\x1b.portfolio.v1.CertificationR\x0ecertifications\")\n" +
+	"\x17GetCertificationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
+	"\x11ListSkillsRequest\",\n" +
+	"\x12ListSkillsResponse\x12\x16\n" +
+	"\x06skills\x18\x01 \x03(\tR\x06skills\"1\n" +
+	"\x13WatchContentRequest\x12\x1a\n" +
+	"\bentities\x18\x01 \x03(\tR\bentities\"\xf8\x02\n" +
+	"\fContentEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06entity\x18\x03 \x01(\tR\x06entity\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1b\n" +
+	"\tentity_id\x18\x05 \x01(\tR\bentityId\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x121\n" +
+	"\aproject\x18\a \x01(\v2\x15.portfolio.v1.ProjectH\x00R\aproject\x12:\n" +
+	"\n" +
+	"experience\x18\b \x01(\v2\x18.portfolio.v1.ExperienceH\x00R\n" +
+	"experience\x12C\n" +
+	"\rcertification\x18\t \x01(\v2\x1b.portfolio.v1.CertificationH\x00R\rcertificationB\x06\n" +
+	"\x04data*\xca\x01\n" +
+	"\x13CertificationStatus\x12$\n" +
+	" CERTIFICATION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bCERTIFICATION_STATUS_ACTIVE\x10\x01\x12&\n" +
+	"\"CERTIFICATION_STATUS_EXPIRING_SOON\x10\x02\x12 \n" +
+	"\x1cCERTIFICATION_STATUS_EXPIRED\x10\x03\x12\"\n" +
+	"\x1eCERTIFICATION_STATUS_NO_EXPIRY\x10\x042\xc1\x05\n" +
+	"\x10PortfolioService\x12U\n" +
+	"\fListProjects\x12!.portfolio.v1.ListProjectsRequest\x1a\".portfolio.v1.ListProjectsResponse\x12D\n" +
+	"\n" +
+	"GetProject\x12\x1f.portfolio.v1.GetProjectRequest\x1a\x15.portfolio.v1.Project\x12^\n" +
+	"\x0fListExperiences\x12$.portfolio.v1.ListExperiencesRequest\x1a%.portfolio.v1.ListExperiencesResponse\x12M\n" +
+	"\rGetExperience\x12\".portfolio.v1.GetExperienceRequest\x1a\x18.portfolio.v1.Experience\x12g\n" +
+	"\x12ListCertifications\x12'.portfolio.v1.ListCertificationsRequest\x1a(.portfolio.v1.ListCertificationsResponse\x12V\n" +
+	"\x10GetCertification\x12%.portfolio.v1.GetCertificationRequest\x1a\x1b.portfolio.v1.Certification\x12O\n" +
+	"\n" +
+	"ListSkills\x12\x1f.portfolio.v1.ListSkillsRequest\x1a .portfolio.v1.ListSkillsResponse\x12O\n" +
+	"\fWatchContent\x12!.portfolio.v1.WatchContentRequest\x1a\x1a.portfolio.v1.ContentEvent0\x01B(Z&github.com/MishraShardendu22/rpc/pb;pbb\x06proto3"
+
+var (
+	file_portfolio_proto_rawDescOnce sync.Once
+	file_portfolio_proto_rawDescData []byte
+)
+
+func file_portfolio_proto_rawDescGZIP() []byte {
+	file_portfolio_proto_rawDescOnce.Do(func() {
+		file_portfolio_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_portfolio_proto_rawDesc), len(file_portfolio_proto_rawDesc)))
+	})
+	return file_portfolio_proto_rawDescData
+}
+
+var file_portfolio_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_portfolio_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_portfolio_proto_goTypes = []any{
+	(CertificationStatus)(0),           // 0: portfolio.v1.CertificationStatus
+	(*Project)(nil),                    // 1: portfolio.v1.Project
+	(*Experience)(nil),                 // 2: portfolio.v1.Experience
+	(*Certification)(nil),              // 3: portfolio.v1.Certification
+	(*ListProjectsRequest)(nil),        // 4: portfolio.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),       // 5: portfolio.v1.ListProjectsResponse
+	(*GetProjectRequest)(nil),          // 6: portfolio.v1.GetProjectRequest
+	(*ListExperiencesRequest)(nil),     // 7: portfolio.v1.ListExperiencesRequest
+	(*ListExperiencesResponse)(nil),    // 8: portfolio.v1.ListExperiencesResponse
+	(*GetExperienceRequest)(nil),       // 9: portfolio.v1.GetExperienceRequest
+	(*ListCertificationsRequest)(nil),  // 10: portfolio.v1.ListCertificationsRequest
+	(*ListCertificationsResponse)(nil), // 11: portfolio.v1.ListCertificationsResponse
+	(*GetCertificationRequest)(nil),    // 12: portfolio.v1.GetCertificationRequest
+	(*ListSkillsRequest)(nil),          // 13: portfolio.v1.ListSkillsRequest
+	(*ListSkillsResponse)(nil),         // 14: portfolio.v1.ListSkillsResponse
+	(*WatchContentRequest)(nil),        // 15: portfolio.v1.WatchContentRequest
+	(*ContentEvent)(nil),               // 16: portfolio.v1.ContentEvent
+	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
+}
+var file_portfolio_proto_depIdxs = []int32{
+	17, // 0: portfolio.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: portfolio.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 2: portfolio.v1.Experience.created_at:type_name -> google.protobuf.Timestamp
+	17, // 3: portfolio.v1.Experience.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: portfolio.v1.Certification.status:type_name -> portfolio.v1.CertificationStatus
+	17, // 5: portfolio.v1.Certification.created_at:type_name -> google.protobuf.Timestamp
+	17, // 6: portfolio.v1.Certification.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: portfolio.v1.ListProjectsResponse.projects:type_name -> portfolio.v1.Project
+	2,  // 8: portfolio.v1.ListExperiencesResponse.experiences:type_name -> portfolio.v1.Experience
+	0,  // 9: portfolio.v1.ListCertificationsRequest.status:type_name -> portfolio.v1.CertificationStatus
+	3,  // 10: portfolio.v1.ListCertificationsResponse.certifications:type_name -> portfolio.v1.Certification
+	17, // 11: portfolio.v1.ContentEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	1,  // 12: portfolio.v1.ContentEvent.project:type_name -> portfolio.v1.Project
+	2,  // 13: portfolio.v1.ContentEvent.experience:type_name -> portfolio.v1.Experience
+	3,  // 14: portfolio.v1.ContentEvent.certification:type_name -> portfolio.v1.Certification
+	4,  // 15: portfolio.v1.PortfolioService.ListProjects:input_type -> portfolio.v1.ListProjectsRequest
+	6,  // 16: portfolio.v1.PortfolioService.GetProject:input_type -> portfolio.v1.GetProjectRequest
+	7,  // 17: portfolio.v1.PortfolioService.ListExperiences:input_type -> portfolio.v1.ListExperiencesRequest
+	9,  // 18: portfolio.v1.PortfolioService.GetExperience:input_type -> portfolio.v1.GetExperienceRequest
+	10, // 19: portfolio.v1.PortfolioService.ListCertifications:input_type -> portfolio.v1.ListCertificationsRequest
+	12, // 20: portfolio.v1.PortfolioService.GetCertification:input_type -> portfolio.v1.GetCertificationRequest
+	13, // 21: portfolio.v1.PortfolioService.ListSkills:input_type -> portfolio.v1.ListSkillsRequest
+	15, // 22: portfolio.v1.PortfolioService.WatchContent:input_type -> portfolio.v1.WatchContentRequest
+	5,  // 23: portfolio.v1.PortfolioService.ListProjects:output_type -> portfolio.v1.ListProjectsResponse
+	1,  // 24: portfolio.v1.PortfolioService.GetProject:output_type -> portfolio.v1.Project
+	8,  // 25: portfolio.v1.PortfolioService.ListExperiences:output_type -> portfolio.v1.ListExperiencesResponse
+	2,  // 26: portfolio.v1.PortfolioService.GetExperience:output_type -> portfolio.v1.Experience
+	11, // 27: portfolio.v1.PortfolioService.ListCertifications:output_type -> portfolio.v1.ListCertificationsResponse
+	3,  // 28: portfolio.v1.PortfolioService.GetCertification:output_type -> portfolio.v1.Certification
+	14, // 29: portfolio.v1.PortfolioService.ListSkills:output_type -> portfolio.v1.ListSkillsResponse
+	16, // 30: portfolio.v1.PortfolioService.WatchContent:output_type -> portfolio.v1.ContentEvent
+	23, // [23:31] is the sub-list for method output_type
+	15, // [15:23] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_portfolio_proto_init() }
+func file_portfolio_proto_init() {
+	if File_portfolio_proto != nil {
+		return
+	}
+	file_portfolio_proto_msgTypes[15].OneofWrappers = []any{
+		(*ContentEvent_Project)(nil),
+		(*ContentEvent_Experience)(nil),
+		(*ContentEvent_Certification)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_portfolio_proto_rawDesc), len(file_portfolio_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_portfolio_proto_goTypes,
+		DependencyIndexes: file_portfolio_proto_depIdxs,
+		EnumInfos:         file_portfolio_proto_enumTypes,
+		MessageInfos:      file_portfolio_proto_msgTypes,
+	}.Build()
+	File_portfolio_proto = out.File
+	file_portfolio_proto_goTypes = nil
+	file_portfolio_proto_depIdxs = nil
+}
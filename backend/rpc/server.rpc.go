@@ -0,0 +1,61 @@
+package rpc
+
+//go:generate protoc -I pb --go_out=pb --go_opt=paths=source_relative --go-grpc_out=pb --go-grpc_opt=paths=source_relative pb/portfolio.proto
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/MishraShardendu22/events"
+	"github.com/MishraShardendu22/rpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// Options configures the gRPC server.
+type Options struct {
+	// ExpiryWindowDays is used to compute certification status.
+	ExpiryWindowDays int
+	// Bus is watched by WatchContent, events.Default when nil.
+	Bus    *events.Bus
+	Logger *slog.Logger
+}
+
+// NewServer returns a gRPC server exposing PortfolioService and the
+// reflection service, so tools such as grpcurl can discover it.
+func NewServer(opts Options) *grpc.Server {
+	if opts.Bus == nil {
+		opts.Bus = events.Default
+	}
+	if opts.Logger == nil {
+		opts.Logger = slog.Default()
+	}
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryLogger(opts.Logger)),
+		grpc.ChainStreamInterceptor(streamLogger(opts.Logger)),
+	)
+	pb.RegisterPortfolioServiceServer(server, &contentService{opts: opts})
+	reflection.Register(server)
+	return server
+}
+
+func unaryLogger(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logger.Info("gRPC", "method", info.FullMethod, "code", status.Code(err).String(), "latency", time.Since(start))
+		return resp, err
+	}
+}
+
+func streamLogger(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logger.Info("gRPC stream", "method", info.FullMethod, "code", status.Code(err).String(), "duration", time.Since(start))
+		return err
+	}
+}
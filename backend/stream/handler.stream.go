@@ -0,0 +1,160 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MishraShardendu22/events"
+	"github.com/MishraShardendu22/util"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ResetEvent tells a client that events it missed are gone and it should
+// reload everything it shows.
+const ResetEvent = "reset"
+
+// Options configures the event stream.
+type Options struct {
+	Bus *events.Bus
+	// Heartbeat is how often a comment is sent on an idle stream, to keep
+	// proxies from closing it and to notice clients that went away.
+	Heartbeat time.Duration
+}
+
+const (
+	// buffer is how many events a stream may fall behind before it is ended.
+	buffer = 64
+	// writeTimeout bounds every write, replacing the server's WriteTimeout
+	// which would otherwise end the stream after a fixed time.
+	writeTimeout = 10 * time.Second
+	// retry is the reconnect delay suggested to EventSource clients.
+	retry = 3 * time.Second
+)
+
+var (
+	closing   = make(chan struct{})
+	closeOnce sync.Once
+)
+
+// Shutdown ends every open stream, so the server can stop without waiting
+// for clients to disconnect. Clients reconnect with Last-Event-ID.
+func Shutdown() {
+	closeOnce.Do(func() { close(closing) })
+}
+
+// Handler streams bus events as Server-Sent Events. `?entities=` limits the
+// stream to some entities, and a Last-Event-ID header (or `?last_event_id=`
+// for a fresh EventSource) replays what was published since that event.
+func Handler(opts Options) fiber.Handler {
+	if opts.Bus == nil {
+		opts.Bus = events.Default
+	}
+	if opts.Heartbeat <= 0 {
+		opts.Heartbeat = 15 * time.Second
+	}
+
+	return func(c *fiber.Ctx) error {
+		watched := map[string]bool{}
+		if list := c.Query("entities"); list != "" {
+			for _, entity := range strings.Split(list, ",") {
+				switch entity = strings.TrimSpace(entity); entity {
+				case events.Project, events.Experience, events.Certification, events.Skills, events.Stats:
+					watched[entity] = true
+				default:
+					return util.ErrorAPI(c, fiber.StatusBadRequest, util.CodeBadRequest,
+						fmt.Sprintf("Unknown entity %q, expected project, experience, certification, skills or stats", entity), nil)
+				}
+			}
+		}
+
+		lastEventID := c.Get("Last-Event-ID", c.Query("last_event_id"))
+		var lastID uint64
+		if lastEventID != "" {
+			id, err := strconv.ParseUint(lastEventID, 10, 64)
+			if err != nil {
+				return util.ErrorAPI(c, fiber.StatusBadRequest, util.CodeBadRequest, "Invalid Last-Event-ID", nil)
+			}
+			lastID = id
+		}
+
+		var (
+			missed      []events.Event
+			complete    = true
+			ch          <-chan events.Event
+			unsubscribe func()
+		)
+		if lastEventID != "" {
+			missed, complete, ch, unsubscribe = opts.Bus.SubscribeFrom(lastID, buffer)
+		} else {
+			ch, unsubscribe = opts.Bus.Subscribe(buffer)
+		}
+
+		c.Set(fiber.HeaderContentType, "text/event-stream")
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		c.Set(fiber.HeaderConnection, "keep-alive")
+		// Stops nginx from buffering the stream
+		c.Set("X-Accel-Buffering", "no")
+
+		conn := c.Context().Conn()
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer unsubscribe()
+
+			send := func(write func()) bool {
+				conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+				write()
+				return w.Flush() == nil
+			}
+			sendEvent := func(e events.Event) bool {
+				if len(watched) > 0 && !watched[e.Entity] {
+					return true
+				}
+				data, err := json.Marshal(e)
+				if err != nil {
+					return true
+				}
+				return send(func() { fmt.Fprintf(w, "id: %d\ndata: %s\n\n", e.ID, data) })
+			}
+
+			if !send(func() { fmt.Fprintf(w, "retry: %d\n\n", retry.Milliseconds()) }) {
+				return
+			}
+			if !complete {
+				if !send(func() { fmt.Fprintf(w, "event: %s\ndata: {}\n\n", ResetEvent) }) {
+					return
+				}
+			}
+			for _, e := range missed {
+				if !sendEvent(e) {
+					return
+				}
+			}
+
+			heartbeat := time.NewTicker(opts.Heartbeat)
+			defer heartbeat.Stop()
+			for {
+				select {
+				case <-closing:
+					return
+				case <-heartbeat.C:
+					if !send(func() { fmt.Fprint(w, ": ping\n\n") }) {
+						return
+					}
+				case e, open := <-ch:
+					if !open {
+						// Fell behind; the client resumes from the last event it got
+						return
+					}
+					if !sendEvent(e) {
+						return
+					}
+				}
+			}
+		})
+		return nil
+	}
+}
@@ -2,7 +2,13 @@ package util
 
 import "github.com/gofiber/fiber/v2"
 
+// ResponseAPI writes a success response, or for statuses of 400 and above the
+// ErrorAPI envelope with the default code for the status and data as details.
 func ResponseAPI(c *fiber.Ctx, status int, message string, data any, token string) error {
+	if status >= fiber.StatusBadRequest {
+		return ErrorAPI(c, status, CodeForStatus(status), message, data)
+	}
+
 	response := map[string]any{
 		"status":  status,
 		"message": message,
@@ -0,0 +1,46 @@
+package util
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NotModified reports whether a GET or HEAD request already holds the current
+// representation. If-None-Match takes precedence over If-Modified-Since and
+// entity tags are compared weakly, as RFC 9110 prescribes for these headers.
+func NotModified(c *fiber.Ctx, etag string, lastModified time.Time) bool {
+	if c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead {
+		return false
+	}
+
+	if noneMatch := c.Get(fiber.HeaderIfNoneMatch); noneMatch != "" {
+		return etagMatches(noneMatch, etag)
+	}
+
+	if modifiedSince := c.Get(fiber.HeaderIfModifiedSince); modifiedSince != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(modifiedSince)
+		if err != nil {
+			return false
+		}
+		return !lastModified.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
+
+func etagMatches(header string, etag string) bool {
+	if strings.TrimSpace(header) == "*" {
+		return etag != ""
+	}
+
+	want := strings.TrimPrefix(etag, "W/")
+	for _, tag := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == want {
+			return true
+		}
+	}
+	return false
+}
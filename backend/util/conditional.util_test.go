@@ -0,0 +1,62 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestNotModified(t *testing.T) {
+	lastModified := time.Date(2024, 3, 10, 12, 0, 0, 500, time.UTC)
+	at := func(d time.Duration) string { return lastModified.Add(d).Format(http.TimeFormat) }
+
+	tests := []struct {
+		name    string
+		method  string
+		headers map[string]string
+		etag    string
+		want    bool
+	}{
+		{"no conditions", fiber.MethodGet, nil, `"v1"`, false},
+		{"matching tag", fiber.MethodGet, map[string]string{"If-None-Match": `"v1"`}, `"v1"`, true},
+		{"other tag", fiber.MethodGet, map[string]string{"If-None-Match": `"v2"`}, `"v1"`, false},
+		{"tag in a list", fiber.MethodGet, map[string]string{"If-None-Match": `"v0", "v1"`}, `"v1"`, true},
+		{"weak against strong", fiber.MethodGet, map[string]string{"If-None-Match": `W/"v1"`}, `"v1"`, true},
+		{"strong against weak", fiber.MethodGet, map[string]string{"If-None-Match": `"abc"`}, `W/"abc"`, true},
+		{"wildcard", fiber.MethodGet, map[string]string{"If-None-Match": "*"}, `"v1"`, true},
+		{"wildcard without a tag", fiber.MethodGet, map[string]string{"If-None-Match": "*"}, "", false},
+		{"head", fiber.MethodHead, map[string]string{"If-None-Match": `"v1"`}, `"v1"`, true},
+		{"not a read", fiber.MethodPut, map[string]string{"If-None-Match": `"v1"`}, `"v1"`, false},
+		// Last-Modified is compared at second precision
+		{"modified since same second", fiber.MethodGet, map[string]string{"If-Modified-Since": at(0)}, "", true},
+		{"modified since later", fiber.MethodGet, map[string]string{"If-Modified-Since": at(time.Hour)}, "", true},
+		{"modified since earlier", fiber.MethodGet, map[string]string{"If-Modified-Since": at(-time.Second)}, "", false},
+		{"invalid date", fiber.MethodGet, map[string]string{"If-Modified-Since": "yesterday"}, "", false},
+		{"tag takes precedence", fiber.MethodGet, map[string]string{"If-None-Match": `"v2"`, "If-Modified-Since": at(time.Hour)}, `"v1"`, false},
+	}
+
+	for _, tt := range tests {
+		app := fiber.New()
+		app.All("/", func(c *fiber.Ctx) error {
+			if NotModified(c, tt.etag, lastModified) {
+				return c.SendStatus(fiber.StatusNotModified)
+			}
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		req := httptest.NewRequest(tt.method, "/", nil)
+		for k, v := range tt.headers {
+			req.Header.Set(k, v)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := resp.StatusCode == fiber.StatusNotModified; got != tt.want {
+			t.Errorf("%s: NotModified = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"strings"
+	"time"
+)
+
+// Dates are stored as free-form strings, so accept the formats the admin
+// panel has historically sent. Month-only dates resolve to the last day of
+// that month so a certificate valid "until March 2025" stays active in March.
+var dayLayouts = []string{
+	time.RFC3339,
+	"2006-01-02",
+	"02-01-2006",
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"2 January 2006",
+	"2 Jan 2006",
+}
+
+var monthLayouts = []string{
+	"2006-01",
+	"01/2006",
+	"January 2006",
+	"Jan 2006",
+}
+
+func ParseDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	for _, layout := range dayLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), true
+		}
+	}
+
+	for _, layout := range monthLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.AddDate(0, 1, -1).UTC(), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func DaysUntil(target, now time.Time) int {
+	y, m, d := target.Date()
+	target = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	y, m, d = now.UTC().Date()
+	now = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return int(target.Sub(now).Hours() / 24)
+}
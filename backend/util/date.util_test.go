@@ -0,0 +1,66 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		value  string
+		want   time.Time
+		wantOK bool
+	}{
+		{"2024-01-31", day(2024, 1, 31), true},
+		{"  2024-01-31 ", day(2024, 1, 31), true},
+		{"31-01-2024", day(2024, 1, 31), true},
+		{"January 2, 2024", day(2024, 1, 2), true},
+		{"Jan 2, 2024", day(2024, 1, 2), true},
+		{"2 January 2024", day(2024, 1, 2), true},
+		{"2 Jan 2024", day(2024, 1, 2), true},
+		{"2024-01-31T23:30:00-02:00", time.Date(2024, 2, 1, 1, 30, 0, 0, time.UTC), true},
+		// Month-only dates resolve to the last day of the month
+		{"2024-02", day(2024, 2, 29), true},
+		{"03/2025", day(2025, 3, 31), true},
+		{"March 2025", day(2025, 3, 31), true},
+		{"Dec 2025", day(2025, 12, 31), true},
+		{"", time.Time{}, false},
+		{"Present", time.Time{}, false},
+		{"2024-13-01", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseDate(tt.value)
+		if ok != tt.wantOK || !got.Equal(tt.want) {
+			t.Errorf("ParseDate(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestDaysUntil(t *testing.T) {
+	now := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		target time.Time
+		now    time.Time
+		want   int
+	}{
+		{"today", time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), now, 0},
+		{"later today", time.Date(2024, 3, 10, 23, 59, 0, 0, time.UTC), now, 0},
+		{"tomorrow", time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), now, 1},
+		{"yesterday", time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC), now, -1},
+		{"across a leap day", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC), 2},
+		{"a year ahead", time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), now, 365},
+		// now is compared by its UTC date, whatever its zone
+		{"now in another zone", time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 10, 22, 0, 0, 0, time.FixedZone("UTC-5", -5*3600)), 0},
+	}
+
+	for _, tt := range tests {
+		if got := DaysUntil(tt.target, tt.now); got != tt.want {
+			t.Errorf("%s: DaysUntil = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
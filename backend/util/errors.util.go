@@ -0,0 +1,98 @@
+package util
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+)
+
+// Error codes are part of the API contract: clients branch on them, so
+// existing values must not change.
+const (
+	CodeBadRequest         = "BAD_REQUEST"
+	CodeValidationFailed   = "VALIDATION_FAILED"
+	CodeUnknownFields      = "UNKNOWN_FIELDS"
+	CodeUnknownReference   = "UNKNOWN_REFERENCE"
+	CodeUnauthorized       = "UNAUTHORIZED"
+	CodeTokenMissing       = "TOKEN_MISSING"
+	CodeTokenInvalid       = "TOKEN_INVALID"
+	CodeTokenExpired       = "TOKEN_EXPIRED"
+	CodeInvalidCredentials = "INVALID_CREDENTIALS"
+	CodeForbidden          = "FORBIDDEN"
+	CodeNotFound           = "NOT_FOUND"
+	CodeRouteNotFound      = "ROUTE_NOT_FOUND"
+	CodeMethodNotAllowed   = "METHOD_NOT_ALLOWED"
+	CodeConflict           = "CONFLICT"
+	CodeReferenced         = "REFERENCED"
+	CodePreconditionFailed = "PRECONDITION_FAILED"
+	CodeVersionConflict    = "VERSION_CONFLICT"
+	CodePayloadTooLarge    = "PAYLOAD_TOO_LARGE"
+	CodeRateLimited        = "RATE_LIMITED"
+	CodeInternal           = "INTERNAL_ERROR"
+	CodeUpstreamFailed     = "UPSTREAM_FAILED"
+	CodeUnavailable        = "SERVICE_UNAVAILABLE"
+)
+
+var statusCodes = map[int]string{
+	fiber.StatusBadRequest:            CodeBadRequest,
+	fiber.StatusUnauthorized:          CodeUnauthorized,
+	fiber.StatusForbidden:             CodeForbidden,
+	fiber.StatusNotFound:              CodeNotFound,
+	fiber.StatusMethodNotAllowed:      CodeMethodNotAllowed,
+	fiber.StatusConflict:              CodeConflict,
+	fiber.StatusPreconditionFailed:    CodePreconditionFailed,
+	fiber.StatusRequestEntityTooLarge: CodePayloadTooLarge,
+	fiber.StatusUnprocessableEntity:   CodeValidationFailed,
+	fiber.StatusTooManyRequests:       CodeRateLimited,
+	fiber.StatusBadGateway:            CodeUpstreamFailed,
+	fiber.StatusServiceUnavailable:    CodeUnavailable,
+}
+
+// CodeForStatus is the default error code for an HTTP status.
+func CodeForStatus(status int) string {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	if status >= fiber.StatusInternalServerError {
+		return CodeInternal
+	}
+	return CodeBadRequest
+}
+
+// FieldError describes one invalid field in a request.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule,omitempty"`
+	Message string `json:"message"`
+}
+
+type ErrorBody struct {
+	Code      string `json:"code"`
+	Details   any    `json:"details,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// RequestID returns the ID assigned by the requestid middleware, or "" when
+// it is not installed.
+func RequestID(c *fiber.Ctx) string {
+	if id, ok := c.Locals(requestid.ConfigDefault.ContextKey).(string); ok {
+		return id
+	}
+	return string(c.Response().Header.Peek(fiber.HeaderXRequestID))
+}
+
+// ErrorAPI writes the error envelope:
+//
+//	{"status": 404, "message": "...", "error": {"code": "NOT_FOUND", "details": ..., "request_id": "..."}}
+//
+// details is optional and usually a []FieldError or the offending values.
+func ErrorAPI(c *fiber.Ctx, status int, code string, message string, details any) error {
+	return c.Status(status).JSON(map[string]any{
+		"status":  status,
+		"message": message,
+		"error": ErrorBody{
+			Code:      code,
+			Details:   details,
+			RequestID: RequestID(c),
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"strconv"
+	"strings"
+)
+
+// VersionETag renders a document version counter as a strong entity tag.
+func VersionETag(version int64) string {
+	return `"v` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ParseIfMatch returns the versions listed in an If-Match header. It returns
+// nil when the header is absent or "*", meaning any current version matches.
+// Anything after a "-" in a version tag, such as the date in "v3-2024-01-31",
+// is ignored. Tags that are not version tags are ignored, so a header naming
+// only foreign tags yields an empty, non-nil slice that matches nothing.
+func ParseIfMatch(header string) []int64 {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil
+	}
+
+	versions := make([]int64, 0, 1)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		tag = strings.Trim(tag, `"`)
+		if !strings.HasPrefix(tag, "v") {
+			continue
+		}
+		number, _, _ := strings.Cut(tag[1:], "-")
+		if v, err := strconv.ParseInt(number, 10, 64); err == nil {
+			versions = append(versions, v)
+		}
+	}
+	return versions
+}
@@ -0,0 +1,40 @@
+package util
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []int64
+	}{
+		{"", nil},
+		{"*", nil},
+		{"  *  ", nil},
+		{`"v3"`, []int64{3}},
+		{`W/"v3"`, []int64{3}},
+		{`"v3", "v4"`, []int64{3, 4}},
+		// Certification tags carry the date their status was computed on
+		{`"v3-2024-01-31"`, []int64{3}},
+		{`"abc", "v7"`, []int64{7}},
+		// Only foreign tags: matches no version rather than any
+		{`"abc"`, []int64{}},
+		{`"v"`, []int64{}},
+		{`"vx"`, []int64{}},
+	}
+
+	for _, tt := range tests {
+		got := ParseIfMatch(tt.header)
+		if (got == nil) != (tt.want == nil) || !slices.Equal(got, tt.want) {
+			t.Errorf("ParseIfMatch(%q) = %#v, want %#v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestVersionETagRoundTrip(t *testing.T) {
+	if got := ParseIfMatch(VersionETag(42)); !slices.Equal(got, []int64{42}) {
+		t.Errorf("ParseIfMatch(VersionETag(42)) = %v, want [42]", got)
+	}
+}
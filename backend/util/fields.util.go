@@ -0,0 +1,129 @@
+package util
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var fieldCache sync.Map
+
+// ModelFields maps the JSON field names of a model to their BSON names. Fields
+// that are computed rather than stored map to "". Embedded structs tagged
+// `bson:",inline"` (such as mgm.DefaultModel) contribute their own fields.
+func ModelFields(model any) map[string]string {
+	t := reflect.TypeOf(model)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.(map[string]string)
+	}
+
+	fields := make(map[string]string)
+	collectFields(t, fields)
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+func collectFields(t reflect.Type, fields map[string]string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		bsonName, bsonOpts, _ := strings.Cut(f.Tag.Get("bson"), ",")
+
+		if f.Anonymous && strings.Contains(bsonOpts, "inline") {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			collectFields(ft, fields)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		jsonName, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if jsonName == "-" {
+			continue
+		}
+		if jsonName == "" {
+			jsonName = f.Name
+		}
+		if bsonName == "-" {
+			bsonName = ""
+		} else if bsonName == "" {
+			bsonName = strings.ToLower(f.Name)
+		}
+		fields[jsonName] = bsonName
+	}
+}
+
+// ParseFields splits a `fields` query value and checks every name against
+// known. It returns nil when raw is empty, meaning all fields.
+func ParseFields(raw string, known map[string]string) (fields []string, invalid []string) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if _, ok := known[name]; !ok {
+			invalid = append(invalid, name)
+			continue
+		}
+		fields = append(fields, name)
+	}
+	return fields, invalid
+}
+
+// SelectFields trims v, a struct or slice of structs, down to the given JSON
+// fields plus keep. A nil fields slice returns v unchanged.
+func SelectFields(v any, fields []string, keep ...string) (any, error) {
+	if fields == nil {
+		return v, nil
+	}
+
+	allowed := make(map[string]bool, len(fields)+len(keep))
+	for _, f := range append(fields, keep...) {
+		allowed[f] = true
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	pick := func(doc map[string]json.RawMessage) map[string]json.RawMessage {
+		for key := range doc {
+			if !allowed[key] {
+				delete(doc, key)
+			}
+		}
+		return doc
+	}
+
+	if reflect.Indirect(reflect.ValueOf(v)).Kind() == reflect.Slice {
+		var docs []map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &docs); err != nil {
+			return nil, err
+		}
+		for i := range docs {
+			docs[i] = pick(docs[i])
+		}
+		return docs, nil
+	}
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return pick(doc), nil
+}
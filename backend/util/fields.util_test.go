@@ -0,0 +1,88 @@
+package util
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+type fieldsDoc struct {
+	ID     string   `json:"id" bson:"_id"`
+	Name   string   `json:"name" bson:"name"`
+	Skills []string `json:"skills" bson:"skills"`
+	Status string   `json:"status,omitempty" bson:"-"`
+	Secret string   `json:"-" bson:"secret"`
+}
+
+func TestModelFields(t *testing.T) {
+	got := ModelFields(&fieldsDoc{})
+	want := map[string]string{"id": "_id", "name": "name", "skills": "skills", "status": ""}
+	if len(got) != len(want) {
+		t.Fatalf("ModelFields = %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("ModelFields[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	known := ModelFields(fieldsDoc{})
+
+	tests := []struct {
+		raw         string
+		wantFields  []string
+		wantInvalid []string
+	}{
+		{"", nil, nil},
+		{"   ", nil, nil},
+		{"name", []string{"name"}, nil},
+		{" name , skills ", []string{"name", "skills"}, nil},
+		{"name,name,,skills", []string{"name", "skills"}, nil},
+		{"status", []string{"status"}, nil},
+		{"name,secret,bogus", []string{"name"}, []string{"secret", "bogus"}},
+		// Only unknown names: an empty selection the caller rejects
+		{"bogus", nil, []string{"bogus"}},
+	}
+
+	for _, tt := range tests {
+		fields, invalid := ParseFields(tt.raw, known)
+		if !slices.Equal(fields, tt.wantFields) || !slices.Equal(invalid, tt.wantInvalid) {
+			t.Errorf("ParseFields(%q) = %v, %v; want %v, %v", tt.raw, fields, invalid, tt.wantFields, tt.wantInvalid)
+		}
+	}
+}
+
+func TestSelectFields(t *testing.T) {
+	doc := fieldsDoc{ID: "1", Name: "Portfolio", Skills: []string{"go"}, Status: "active"}
+
+	tests := []struct {
+		name   string
+		v      any
+		fields []string
+		keep   []string
+		want   string
+	}{
+		{"all fields", doc, nil, nil, `{"id":"1","name":"Portfolio","skills":["go"],"status":"active"}`},
+		{"selected", doc, []string{"name"}, []string{"id"}, `{"id":"1","name":"Portfolio"}`},
+		{"pointer", &doc, []string{"skills"}, nil, `{"skills":["go"]}`},
+		{"empty selection", doc, []string{}, []string{"id"}, `{"id":"1"}`},
+		{"omitted field", fieldsDoc{ID: "2"}, []string{"status"}, []string{"id"}, `{"id":"2"}`},
+		{"slice", []fieldsDoc{doc, {ID: "2", Name: "Blog"}}, []string{"name"}, []string{"id"}, `[{"id":"1","name":"Portfolio"},{"id":"2","name":"Blog"}]`},
+	}
+
+	for _, tt := range tests {
+		got, err := SelectFields(tt.v, tt.fields, tt.keep...)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		raw, err := json.Marshal(got)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if string(raw) != tt.want {
+			t.Errorf("%s: SelectFields = %s, want %s", tt.name, raw, tt.want)
+		}
+	}
+}
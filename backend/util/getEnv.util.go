@@ -1,6 +1,10 @@
 package util
 
-import "os"
+import (
+	"os"
+	"strconv"
+	"strings"
+)
 
 func GetEnv(key, fallback string) string {
 	if value := os.Getenv(key); value != "" {
@@ -8,3 +12,21 @@ func GetEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func GetEnvInt(key string, fallback int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return fallback
+}
+
+// GetEnvList splits a comma-separated variable, skipping empty items.
+func GetEnvList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,123 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	// Report fields by their JSON names, which is what clients send
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	// date accepts any format ParseDate understands
+	v.RegisterValidation("date", func(fl validator.FieldLevel) bool {
+		_, ok := ParseDate(fl.Field().String())
+		return ok
+	})
+
+	// date_or_present also accepts "Present" for ongoing positions
+	v.RegisterValidation("date_or_present", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		if strings.EqualFold(strings.TrimSpace(value), "present") {
+			return true
+		}
+		_, ok := ParseDate(value)
+		return ok
+	})
+
+	// notblank rejects strings that are only whitespace
+	v.RegisterValidation("notblank", func(fl validator.FieldLevel) bool {
+		return strings.TrimSpace(fl.Field().String()) != ""
+	})
+
+	return v
+}
+
+// ruleMessages holds the messages of rules added with RegisterValidation.
+var ruleMessages = map[string]string{}
+
+// RegisterValidation adds a rule for string fields named tag, for packages
+// whose rules util should not depend on. message completes "<field> ..." when
+// a field fails it. Call it from init, before anything is validated.
+func RegisterValidation(tag string, valid func(string) bool, message string) {
+	err := validate.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+		return valid(fl.Field().String())
+	})
+	if err != nil {
+		panic(fmt.Sprintf("validation rule %q: %v", tag, err))
+	}
+	ruleMessages[tag] = message
+}
+
+// Validate checks v against its `validate` struct tags and returns one
+// FieldError per failing field, or nil when v is valid.
+func Validate(v any) []FieldError {
+	err := validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return []FieldError{{Field: "", Rule: "invalid", Message: err.Error()}}
+	}
+
+	fields := make([]FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		// Namespace is "StructName.field[0]"; drop the struct name
+		_, field, _ := strings.Cut(fe.Namespace(), ".")
+		fields = append(fields, FieldError{
+			Field:   field,
+			Rule:    fe.Tag(),
+			Message: field + " " + ruleMessage(fe),
+		})
+	}
+	return fields
+}
+
+func ruleMessage(fe validator.FieldError) string {
+	unit := "characters"
+	if k := fe.Kind(); k == reflect.Slice || k == reflect.Array || k == reflect.Map {
+		unit = "items"
+	}
+
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "max":
+		return fmt.Sprintf("must be at most %s %s", fe.Param(), unit)
+	case "min":
+		return fmt.Sprintf("must be at least %s %s", fe.Param(), unit)
+	case "url", "http_url":
+		return "must be a valid URL"
+	case "email":
+		return "must be a valid email address"
+	case "date":
+		return "must be a date such as 2024-01-31, Jan 2, 2024 or Jan 2024"
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case "date_or_present":
+		return "must be a date or Present"
+	case "notblank":
+		return "must not be blank"
+	default:
+		if message, ok := ruleMessages[fe.Tag()]; ok {
+			return message
+		}
+		return "failed the " + fe.Tag() + " rule"
+	}
+}
@@ -0,0 +1,80 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+type validateDoc struct {
+	Name  string   `json:"name" validate:"required,notblank,max=5"`
+	Email string   `json:"email" validate:"omitempty,email"`
+	Link  string   `json:"link" validate:"omitempty,url"`
+	Start string   `json:"start" validate:"omitempty,date"`
+	End   string   `json:"end" validate:"omitempty,date_or_present"`
+	Kind  string   `json:"kind" validate:"omitempty,oneof=a b"`
+	Tags  []string `json:"tags" validate:"max=2,dive,required,max=3"`
+	Note  string   `json:"note" validate:"omitempty,min=3"`
+	Code  string   `json:"code" validate:"omitempty,upper_code"`
+}
+
+func init() {
+	RegisterValidation("upper_code", func(v string) bool { return v == strings.ToUpper(v) }, "must be upper case")
+}
+
+func TestValidate(t *testing.T) {
+	valid := func(edit func(*validateDoc)) validateDoc {
+		doc := validateDoc{Name: "Ana", Tags: []string{"go"}}
+		if edit != nil {
+			edit(&doc)
+		}
+		return doc
+	}
+
+	tests := []struct {
+		name string
+		doc  validateDoc
+		want []FieldError
+	}{
+		{"valid", valid(nil), nil},
+		{"every optional rule passing", valid(func(d *validateDoc) {
+			d.Email, d.Link, d.Start, d.End, d.Kind, d.Note, d.Code = "a@b.co", "https://x.dev", "Jan 2024", "present", "b", "abc", "OK"
+		}), nil},
+		{"required", valid(func(d *validateDoc) { d.Name = "" }), []FieldError{{"name", "required", "name is required"}}},
+		{"notblank", valid(func(d *validateDoc) { d.Name = "   " }), []FieldError{{"name", "notblank", "name must not be blank"}}},
+		{"max characters", valid(func(d *validateDoc) { d.Name = "Anastasia" }), []FieldError{{"name", "max", "name must be at most 5 characters"}}},
+		{"min characters", valid(func(d *validateDoc) { d.Note = "hi" }), []FieldError{{"note", "min", "note must be at least 3 characters"}}},
+		{"email", valid(func(d *validateDoc) { d.Email = "nope" }), []FieldError{{"email", "email", "email must be a valid email address"}}},
+		{"url", valid(func(d *validateDoc) { d.Link = "not a url" }), []FieldError{{"link", "url", "link must be a valid URL"}}},
+		{"date", valid(func(d *validateDoc) { d.Start = "soon" }), []FieldError{{"start", "date", "start must be a date such as 2024-01-31, Jan 2, 2024 or Jan 2024"}}},
+		{"date_or_present", valid(func(d *validateDoc) { d.End = "later" }), []FieldError{{"end", "date_or_present", "end must be a date or Present"}}},
+		{"oneof", valid(func(d *validateDoc) { d.Kind = "c" }), []FieldError{{"kind", "oneof", "kind must be one of a, b"}}},
+		{"max items", valid(func(d *validateDoc) { d.Tags = []string{"a", "b", "c"} }), []FieldError{{"tags", "max", "tags must be at most 2 items"}}},
+		// Slice elements are reported by index
+		{"dive", valid(func(d *validateDoc) { d.Tags = []string{"go", "rust"} }), []FieldError{{"tags[1]", "max", "tags[1] must be at most 3 characters"}}},
+		{"registered rule", valid(func(d *validateDoc) { d.Code = "ok" }), []FieldError{{"code", "upper_code", "code must be upper case"}}},
+		{"several fields", valid(func(d *validateDoc) { d.Name, d.Email = "", "nope" }), []FieldError{
+			{"name", "required", "name is required"},
+			{"email", "email", "email must be a valid email address"},
+		}},
+	}
+
+	for _, tt := range tests {
+		got := Validate(tt.doc)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: Validate = %+v, want %+v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: Validate[%d] = %+v, want %+v", tt.name, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestValidateNonStruct(t *testing.T) {
+	got := Validate("not a struct")
+	if len(got) != 1 || got[0].Rule != "invalid" {
+		t.Errorf("Validate(string) = %+v, want one invalid error", got)
+	}
+}
@@ -0,0 +1,128 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/notifier"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// claim takes the oldest due delivery and pushes its next attempt past the
+// lease, so no other worker picks it up while it is being sent.
+func (d *Dispatcher) claim(ctx context.Context) (models.WebhookDelivery, bool) {
+	now := time.Now()
+	filter := bson.M{"status": models.DeliveryPending, "next_attempt_at": bson.M{"$lte": now}}
+	update := bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"next_attempt_at": 1}).SetReturnDocument(options.After)
+
+	var delivery models.WebhookDelivery
+	err := mgm.Coll(&delivery).FindOneAndUpdate(ctx, filter, update, opts).Decode(&delivery)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) && ctx.Err() == nil {
+			d.opts.Logger.Error("Failed to claim webhook delivery", "error", err)
+		}
+		return delivery, false
+	}
+	return delivery, true
+}
+
+// attempt sends delivery once and records the outcome, scheduling a retry
+// with exponential backoff until MaxAttempts is reached.
+func (d *Dispatcher) attempt(ctx context.Context, delivery models.WebhookDelivery) {
+	now := time.Now()
+	set := bson.M{"attempts": delivery.Attempts + 1, "updated_at": now}
+
+	var sub models.WebhookSubscription
+	err := mgm.Coll(&sub).FindByIDWithCtx(ctx, delivery.SubscriptionID, &sub)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		set["status"], set["last_error"] = models.DeliveryFailed, "subscription was deleted"
+	case err != nil:
+		// Not the endpoint's fault, so it does not count as an attempt
+		d.opts.Logger.Error("Failed to load webhook subscription", "delivery", delivery.ID.Hex(), "error", err)
+		return
+	case !sub.Active:
+		set["status"], set["last_error"] = models.DeliveryFailed, "subscription is inactive"
+	default:
+		code, sendErr := d.send(ctx, sub, delivery)
+		set["last_status_code"] = code
+		switch {
+		case sendErr == nil:
+			set["status"], set["delivered_at"], set["last_error"] = models.DeliverySucceeded, now, ""
+		case delivery.Attempts+1 >= d.opts.MaxAttempts:
+			set["status"], set["last_error"] = models.DeliveryFailed, sendErr.Error()
+			d.reportFailure(ctx, sub, delivery, sendErr)
+		default:
+			set["last_error"] = sendErr.Error()
+			set["next_attempt_at"] = now.Add(d.backoff(delivery.Attempts + 1))
+		}
+	}
+
+	if _, err := mgm.Coll(&delivery).UpdateByID(ctx, delivery.ID, bson.M{"$set": set}); err != nil {
+		d.opts.Logger.Error("Failed to record webhook delivery", "delivery", delivery.ID.Hex(), "error", err)
+	}
+}
+
+// backoff is the delay after the given number of failed attempts.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.opts.RetryBase
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
+
+// send POSTs the signed payload and returns the response status. Any status
+// outside 2xx is an error.
+func (d *Dispatcher) send(ctx context.Context, sub models.WebhookSubscription, delivery models.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Portfolio-Webhooks/1.0")
+	req.Header.Set(HeaderEventID, delivery.EventID)
+	req.Header.Set(HeaderDelivery, delivery.ID.Hex())
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, body))
+
+	resp, err := d.opts.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a little so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("endpoint returned %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// reportFailure tells the notifier that delivery was given up on.
+func (d *Dispatcher) reportFailure(ctx context.Context, sub models.WebhookSubscription, delivery models.WebhookDelivery, err error) {
+	if d.opts.Notifier == nil {
+		return
+	}
+	message := fmt.Sprintf("Delivery %s of %s to %s failed %d times and will not be retried.",
+		delivery.ID.Hex(), delivery.EventType, sub.URL, delivery.Attempts+1)
+	if err := d.opts.Notifier.Notify(ctx, notifier.JobFailed("webhook-delivery", message, err)); err != nil {
+		d.opts.Logger.Error("Failed to report webhook delivery failure", "delivery", delivery.ID.Hex(), "error", err)
+	}
+}
@@ -0,0 +1,260 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/MishraShardendu22/events"
+	"github.com/MishraShardendu22/models"
+	"github.com/MishraShardendu22/notifier"
+	"github.com/MishraShardendu22/util"
+	"github.com/kamva/mgm/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PingEvent is the type of the test event sent by Ping.
+const PingEvent = "webhook.ping"
+
+// event_pattern accepts subscription event selectors such as project.* or *
+func init() {
+	util.RegisterValidation("event_pattern", events.ValidPattern,
+		"must be an event type such as project.created, an entity wildcard such as project.* or *")
+}
+
+// Payload is the JSON body of every delivery.
+type Payload struct {
+	// ID identifies the event and is the same across redeliveries, so
+	// receivers can use it to ignore duplicates.
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	Entity     string    `json:"entity,omitempty"`
+	Action     string    `json:"action,omitempty"`
+	EntityID   string    `json:"entity_id,omitempty"`
+	Data       any       `json:"data,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type Options struct {
+	// MaxAttempts is how many times a delivery is tried before it fails.
+	MaxAttempts int
+	// RetryBase is the delay before the first retry; it doubles with every
+	// further attempt, up to maxBackoff.
+	RetryBase time.Duration
+	// PollInterval is how often due retries are looked for.
+	PollInterval time.Duration
+	Client       *http.Client
+	Logger       *slog.Logger
+	// Notifier, when set, is told about deliveries that ran out of attempts.
+	Notifier notifier.Notifier
+}
+
+const (
+	maxBackoff = 6 * time.Hour
+	// lease hides a delivery from other workers while it is being sent
+	lease   = time.Minute
+	workers = 4
+	// busBuffer is how many events may queue up while subscriptions are
+	// being looked up
+	busBuffer = 256
+)
+
+// Dispatcher turns content events into persisted deliveries and sends them.
+// Deliveries live in MongoDB, so pending retries survive restarts and are
+// claimed atomically when several instances run.
+type Dispatcher struct {
+	opts Options
+	wake chan struct{}
+}
+
+func New(opts Options) *Dispatcher {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 8
+	}
+	if opts.RetryBase <= 0 {
+		opts.RetryBase = 30 * time.Second
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 5 * time.Second
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{
+			Timeout: 10 * time.Second,
+			// A redirect is reported as a failed delivery rather than followed
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		}
+	}
+	if opts.Logger == nil {
+		opts.Logger = slog.Default()
+	}
+	return &Dispatcher{opts: opts, wake: make(chan struct{}, 1)}
+}
+
+// Default is the dispatcher the admin webhook handlers use.
+var Default = New(Options{})
+
+// Start queues deliveries for every event published on bus and sends them
+// until ctx is cancelled.
+func (d *Dispatcher) Start(ctx context.Context, bus *events.Bus) {
+	go d.listen(ctx, bus)
+	go d.work(ctx)
+}
+
+func (d *Dispatcher) listen(ctx context.Context, bus *events.Bus) {
+	for {
+		ch, unsubscribe := bus.Subscribe(busBuffer)
+		for open := true; open; {
+			select {
+			case <-ctx.Done():
+				unsubscribe()
+				return
+			case e, ok := <-ch:
+				if !ok {
+					d.opts.Logger.Warn("Webhook dispatcher fell behind, some events were not delivered")
+					open = false
+					break
+				}
+				if !events.IsContent(e.Entity) {
+					continue
+				}
+				if err := d.enqueue(ctx, e); err != nil {
+					d.opts.Logger.Error("Failed to queue webhook deliveries", "event", e.Type, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// enqueue stores a pending delivery of e for every active subscription that
+// selects it.
+func (d *Dispatcher) enqueue(ctx context.Context, e events.Event) error {
+	var subs []models.WebhookSubscription
+	if err := mgm.Coll(&models.WebhookSubscription{}).SimpleFindWithCtx(ctx, &subs, bson.M{"active": true}); err != nil {
+		return err
+	}
+
+	var matching []models.WebhookSubscription
+	for _, sub := range subs {
+		for _, pattern := range sub.Events {
+			if events.Matches(pattern, e.Type) {
+				matching = append(matching, sub)
+				break
+			}
+		}
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	payload := Payload{
+		ID:         primitive.NewObjectID().Hex(),
+		Type:       e.Type,
+		Entity:     e.Entity,
+		Action:     e.Action,
+		EntityID:   e.EntityID,
+		Data:       e.Data,
+		OccurredAt: e.At,
+	}
+	for _, sub := range matching {
+		if _, err := d.create(ctx, sub.ID, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ping queues a test event for sub, regardless of its event patterns.
+func (d *Dispatcher) Ping(ctx context.Context, sub models.WebhookSubscription) (models.WebhookDelivery, error) {
+	return d.create(ctx, sub.ID, Payload{
+		ID:         primitive.NewObjectID().Hex(),
+		Type:       PingEvent,
+		Data:       map[string]string{"subscription_id": sub.ID.Hex()},
+		OccurredAt: time.Now().UTC(),
+	})
+}
+
+// Redeliver queues a new delivery with the same event and body as original.
+// The original stays in the log unchanged.
+func (d *Dispatcher) Redeliver(ctx context.Context, original models.WebhookDelivery) (models.WebhookDelivery, error) {
+	delivery := models.WebhookDelivery{
+		SubscriptionID: original.SubscriptionID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         models.DeliveryPending,
+		NextAttemptAt:  time.Now(),
+		RedeliveryOf:   &original.ID,
+	}
+	return delivery, d.insert(ctx, &delivery)
+}
+
+func (d *Dispatcher) create(ctx context.Context, subID primitive.ObjectID, payload Payload) (models.WebhookDelivery, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+
+	delivery := models.WebhookDelivery{
+		SubscriptionID: subID,
+		EventID:        payload.ID,
+		EventType:      payload.Type,
+		Payload:        string(body),
+		Status:         models.DeliveryPending,
+		NextAttemptAt:  time.Now(),
+	}
+	return delivery, d.insert(ctx, &delivery)
+}
+
+func (d *Dispatcher) insert(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if err := mgm.Coll(delivery).CreateWithCtx(ctx, delivery); err != nil {
+		return err
+	}
+	d.poke()
+	return nil
+}
+
+// poke wakes the workers without waiting for the next poll.
+func (d *Dispatcher) poke() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (d *Dispatcher) work(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.drain(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// drain sends every due delivery, a few at a time.
+func (d *Dispatcher) drain(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				delivery, ok := d.claim(ctx)
+				if !ok {
+					return
+				}
+				d.attempt(ctx, delivery)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,66 @@
+package webhooks
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"evt_1"}`)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      []byte
+		want      string
+	}{
+		{"payload", "whsec_test", 1700000000, body, "sha256=c89214b5b5da833daed6f0b8c5bb6bd58cea9022bd80ccc78230f3942d632925"},
+		// The timestamp is signed, so a replay with a new one fails to verify
+		{"other timestamp", "whsec_test", 1700000001, body, "sha256=a6b8e4670849f25456dbcceec15faae9edf44ea78d5607a06ebcb96ce7583658"},
+		{"other secret", "other", 1700000000, body, "sha256=e12ef238930e9a9dcbebaf3147df8d7a19ab1524ac7be39f4f8d50cb628f0ab5"},
+		{"empty body", "whsec_test", 1700000000, nil, "sha256=5967f3c560522fa40cf2876ebc3c3a08551dd6959aaade3b413460591895bdcc"},
+	}
+
+	for _, tt := range tests {
+		if got := Sign(tt.secret, tt.timestamp, tt.body); got != tt.want {
+			t.Errorf("%s: Sign = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	a, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := NewSecret()
+	if !strings.HasPrefix(a, "whsec_") || len(a) != len("whsec_")+64 || a == b {
+		t.Errorf("NewSecret = %q, %q; want two distinct whsec_ secrets", a, b)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := New(Options{RetryBase: time.Minute})
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{6, 32 * time.Minute},
+		{9, 256 * time.Minute},
+		// Capped at maxBackoff
+		{10, maxBackoff},
+		{50, maxBackoff},
+	}
+
+	for _, tt := range tests {
+		if got := d.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -77,7 +77,7 @@ export default function AdminCertificationsPage() {
       const projectsRes = await projectsAPI.getAllProjects()
       setAllProjects(
         Array.isArray(projectsRes.data)
-          ? projectsRes.data.map((p: any) => ({ id: p.inline.id, name: p.project_name }))
+          ? projectsRes.data.map((p: any) => ({ id: p.id, name: p.project_name }))
           : []
       )
       const skillsRes = await skillsAPI.getSkills()
@@ -111,7 +111,7 @@ export default function AdminCertificationsPage() {
         images: data.images ? data.images.split(',').map((img) => img.trim()) : [],
       }
       if (editingCertification) {
-        await certificationsAPI.updateCertification(editingCertification.inline.id, certData)
+        await certificationsAPI.updateCertification(editingCertification.id, certData)
         setSuccess('Certification updated successfully')
       } else {
         await certificationsAPI.createCertification(certData)
@@ -223,7 +223,7 @@ export default function AdminCertificationsPage() {
           <div className="grid gap-8 md:grid-cols-2">
             {currentData.map((cert) => (
               <Card
-                key={cert.inline?.id || cert.inline.id}
+                key={cert.id}
                 className="group relative overflow-hidden border-2 border-border/50 hover:border-primary/50 transition-all duration-500 hover:shadow-2xl hover:shadow-primary/10 hover:-translate-y-2 bg-gradient-to-br from-card/50 to-card backdrop-blur-sm rounded-2xl animate-fade-in flex flex-col max-w-full"
               >
                 <CardHeader className="bg-gradient-to-r from-primary/10 to-card pb-1 px-4">
@@ -260,7 +260,7 @@ export default function AdminCertificationsPage() {
                     <Button
                       size="sm"
                       variant="destructive"
-                      onClick={() => handleDelete(cert.inline.id)}
+                      onClick={() => handleDelete(cert.id)}
                       className="flex-1"
                     >
                       <Trash2 className="h-4 w-4 mr-1" /> Delete
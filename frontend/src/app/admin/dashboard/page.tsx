@@ -174,7 +174,7 @@ export default function AdminDashboardPage() {
               <div className="space-y-3">
                 {projects.slice(0, 3).map((project) => (
                   <div
-                    key={project.inline.id}
+                    key={project.id}
                     className="flex items-center justify-between gap-4 p-3 rounded-lg bg-primary/5 hover:bg-primary/10 transition-all duration-200"
                   >
                     <div className="flex-1 min-w-0">
@@ -237,7 +237,7 @@ export default function AdminDashboardPage() {
               <div className="space-y-3">
                 {experiences.slice(0, 3).map((experience) => (
                   <div
-                    key={experience.inline.id}
+                    key={experience.id}
                     className="flex items-center justify-between gap-4 p-3 rounded-lg bg-secondary/5 hover:bg-secondary/10 transition-all duration-200"
                   >
                     <div className="flex-1 min-w-0">
@@ -88,7 +88,7 @@ export default function AdminExperiencesPage() {
     projectsAPI.getAllProjects().then((res) => {
       setAllProjects(
         Array.isArray(res.data)
-          ? res.data.map((p: any) => ({ id: p.inline.id, name: p.project_name }))
+          ? res.data.map((p: any) => ({ id: p.id, name: p.project_name }))
           : []
       )
     })
@@ -141,7 +141,7 @@ export default function AdminExperiencesPage() {
         images: data.images.split(',').map((img) => img.trim()),
       }
       if (editingExperience) {
-        await experiencesAPI.updateExperience(editingExperience.inline.id, experienceData)
+        await experiencesAPI.updateExperience(editingExperience.id, experienceData)
         setSuccess('Experience updated successfully')
       } else {
         await experiencesAPI.createExperience(experienceData)
@@ -409,7 +409,7 @@ export default function AdminExperiencesPage() {
           <div className="grid gap-6 md:grid-cols-2 lg:grid-cols-3">
             {currentData.map((exp) => (
               <Card
-                key={exp.inline?.id || exp.inline.id}
+                key={exp.id}
                 className="group relative overflow-hidden border-2 border-border/50 hover:border-secondary/50 transition-all duration-500 hover:shadow-2xl hover:shadow-secondary/10 hover:-translate-y-2 bg-gradient-to-br from-card/50 to-card backdrop-blur-sm rounded-2xl animate-fade-in flex flex-col"
               >
                 <CardHeader className="bg-gradient-to-r from-secondary/10 to-card pb-2">
@@ -451,7 +451,7 @@ export default function AdminExperiencesPage() {
                     <Button
                       size="sm"
                       variant="destructive"
-                      onClick={() => handleDelete(exp.inline.id)}
+                      onClick={() => handleDelete(exp.id)}
                       className="flex-1"
                     >
                       <Trash2 className="h-4 w-4 mr-1" /> Delete
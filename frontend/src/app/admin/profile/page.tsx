@@ -95,7 +95,7 @@ export default function AdminProfilePage() {
               </Label>
               <Input
                 id="user-id"
-                value={profile?.inline?.id || ''}
+                value={profile?.id || ''}
                 disabled
                 className="bg-muted text-foreground mt-1"
               />
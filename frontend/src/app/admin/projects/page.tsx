@@ -133,7 +133,7 @@ export default function AdminProjectsPage() {
       }
 
       if (editingProject) {
-        await projectsAPI.updateProject(editingProject.inline.id, projectData)
+        await projectsAPI.updateProject(editingProject.id, projectData)
         setSuccess('Project updated successfully')
         toast.success('Project updated successfully')
       } else {
@@ -391,7 +391,7 @@ export default function AdminProjectsPage() {
           <div className="grid grid-cols-1 py-4 md:grid-cols-2 gap-8">
             {paginatedProjects.map((project) => (
               <Card
-                key={project.inline.id}
+                key={project.id}
                 className="group relative overflow-hidden border-2 border-border/50 hover:border-primary/50 transition-all duration-500 hover:shadow-2xl hover:shadow-primary/10 hover:-translate-y-2 bg-gradient-to-br from-card/50 to-card backdrop-blur-sm rounded-2xl animate-fade-in flex flex-col"
               >
                 <CardHeader className="bg-gradient-to-r from-primary/10 to-card ">
@@ -466,7 +466,7 @@ export default function AdminProjectsPage() {
                         <Button
                           size="sm"
                           variant="destructive"
-                          onClick={() => handleDelete(project.inline.id)}
+                          onClick={() => handleDelete(project.id)}
                           className="flex-1"
                         >
                           <Trash2 className="h-4 w-4 mr-1" /> Delete
@@ -42,7 +42,7 @@ async function proxy(req: NextRequest, id: any) {
 
   const target = targets[index++ % targets.length]
   const reqUrl = new URL(req.url)
-  const backendUrl = `${target}/api/certifications/${id}${reqUrl.search}`
+  const backendUrl = `${target}/api/v1/certifications/${id}${reqUrl.search}`
 
   const headers: Record<string, string> = {}
   for (const [k, v] of req.headers.entries()) {
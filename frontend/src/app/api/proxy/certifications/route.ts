@@ -31,7 +31,7 @@ async function proxy(req: NextRequest) {
 
   const url = new URL(req.url)
   const fullUrl =
-    target + url.pathname.replace('/api/proxy/certifications', '/api/certifications') + url.search
+    target + url.pathname.replace('/api/proxy/certifications', '/api/v1/certifications') + url.search
 
   const method = req.method || 'GET'
   const headers = Object.fromEntries(req.headers.entries())
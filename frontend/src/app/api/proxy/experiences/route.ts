@@ -32,7 +32,7 @@ async function proxy(req: NextRequest) {
   const url = new URL(req.url)
 
   const fullUrl =
-    target + url.pathname.replace('/api/proxy/experiences', '/api/experiences') + url.search
+    target + url.pathname.replace('/api/proxy/experiences', '/api/v1/experiences') + url.search
 
   const method = req.method || 'GET'
   const headers = Object.fromEntries(req.headers.entries())
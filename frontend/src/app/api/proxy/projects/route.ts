@@ -31,7 +31,7 @@ async function proxy(req: NextRequest) {
 
   const url = new URL(req.url)
 
-  const fullUrl = target + url.pathname.replace('/api/proxy/projects', '/api/projects') + url.search
+  const fullUrl = target + url.pathname.replace('/api/proxy/projects', '/api/v1/projects') + url.search
 
   const method = req.method || 'GET'
   const headers = Object.fromEntries(req.headers.entries())
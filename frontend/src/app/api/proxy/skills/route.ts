@@ -31,7 +31,7 @@ async function proxy(req: NextRequest) {
 
   const url = new URL(req.url)
 
-  const fullUrl = target + url.pathname.replace('/api/proxy/skills', '/api/skills') + url.search
+  const fullUrl = target + url.pathname.replace('/api/proxy/skills', '/api/v1/skills') + url.search
 
   const method = req.method || 'GET'
   const headers = Object.fromEntries(req.headers.entries())
@@ -73,7 +73,7 @@ export default function CertificationPageContent() {
     title: certification.title,
     issuer: certification.issuer,
     description: certification.description,
-    link: `/certifications/${certification.inline?.id || certification.inline.id}`,
+    link: `/certifications/${certification.id}`,
     skills: certification.skills,
     certificateUrl: certification.certificate_url,
     issueDate: certification.issue_date,
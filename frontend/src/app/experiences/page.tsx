@@ -70,7 +70,7 @@ export default function ExperiencePageContent() {
     title: experience.position,
     company: experience.company_name,
     description: experience.description,
-    link: `/experiences/${experience.inline?.id || experience.inline.id}`,
+    link: `/experiences/${experience.id}`,
     technologies: experience.technologies,
     certificateUrl: experience.certificate_url,
     startDate: experience.start_date,
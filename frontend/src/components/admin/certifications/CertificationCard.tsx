@@ -27,7 +27,7 @@ export function CertificationCard({ cert, onEdit, onDelete }: CertificationCardP
             <Button variant="ghost" size="sm" onClick={() => onEdit(cert)}>
               <Edit className="h-4 w-4" />
             </Button>
-            <Button variant="ghost" size="sm" onClick={() => onDelete(cert.inline.id)}>
+            <Button variant="ghost" size="sm" onClick={() => onDelete(cert.id)}>
               <Trash2 className="h-4 w-4" />
             </Button>
           </div>
@@ -27,7 +27,7 @@ export default function ProjectsSection({ projects }: ProjectsSectionProps) {
 
   const heroParallaxProjects = useMemo(() => {
     return projects.slice(0, 15).map((project) => ({
-      inline: project.inline,
+      id: project.id,
       project_name: project.project_name,
       small_description: project.small_description,
       skills: project.skills,
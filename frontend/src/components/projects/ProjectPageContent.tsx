@@ -42,7 +42,7 @@ export default function ProjectPageContent() {
   const transformedProjects = currentProjects.map((project) => ({
     title: project.project_name,
     description: project.small_description,
-    link: `/projects/${project.inline?.id || project.inline.id}`,
+    link: `/projects/${project.id}`,
     skills: project.skills,
     repository: project.project_repository,
     liveLink: project.project_live_link,
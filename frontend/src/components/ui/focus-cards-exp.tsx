@@ -104,7 +104,7 @@ export const ExperienceFocusCard = React.memo(
           </div>
 
           <div className="flex flex-col gap-2 sm:gap-3 mt-4 sm:mt-6">
-            <Link href={`/experiences/${exp.inline?.id || exp.inline.id}`} className="w-full">
+            <Link href={`/experiences/${exp.id}`} className="w-full">
               <Button
                 size={isMobile ? 'sm' : 'default'}
                 className="w-full bg-gradient-to-r from-primary to-secondary hover:from-primary/90 hover:to-secondary/90 text-primary-foreground border-0 shadow-lg hover:shadow-primary/25 transition-all duration-300 font-semibold touch-manipulation"
@@ -167,7 +167,7 @@ export function ExperienceFocusCards({
       <div className="grid gap-4 sm:gap-6 lg:gap-8 grid-cols-1 lg:grid-cols-2">
         {experiences.map((exp, index) => (
           <ExperienceFocusCard
-            key={exp.inline?.id || exp.inline.id}
+            key={exp.id}
             exp={exp}
             index={index}
             hovered={hovered}
@@ -115,7 +115,7 @@ export const ProjectFocusCard = React.memo(
             </div>
 
             <Link
-              href={`/projects/${project.inline?.id || project.inline.id}`}
+              href={`/projects/${project.id}`}
               className="w-full sm:mt-0"
             >
               <Button
@@ -154,7 +154,7 @@ export function ProjectFocusCards({
       <div className="grid gap-4 sm:gap-6 lg:gap-8 grid-cols-1 lg:grid-cols-2">
         {projects.map((project, index) => (
           <ProjectFocusCard
-            key={project.inline?.id || project.inline.id}
+            key={project.id}
             project={project}
             index={index}
             hovered={hovered}
@@ -95,7 +95,7 @@ export const CertificationFocusCard = React.memo(
 
           <div className="flex flex-col sm:flex-row sm:items-center sm:justify-between gap-3 pt-3 border-t border-border/30">
             <Link
-              href={`/certifications/${cert.inline?.id || cert.inline.id}`}
+              href={`/certifications/${cert.id}`}
               className="w-full sm:w-auto"
             >
               <Button
@@ -146,7 +146,7 @@ export function CertificationFocusCards({
     <div className="mx-auto mt-8 sm:mt-16 lg:mt-20 grid max-w-2xl grid-cols-1 gap-4 sm:gap-6 lg:mx-0 lg:max-w-none lg:grid-cols-2">
       {certifications.map((cert, index) => (
         <CertificationFocusCard
-          key={cert.inline?.id || cert.inline.id}
+          key={cert.id}
           cert={cert}
           index={index}
           hovered={hovered}
@@ -8,7 +8,7 @@ import { ExternalLink, Github, ArrowRight } from 'lucide-react'
 import Link from 'next/link'
 
 export interface HeroProject {
-  inline: { id: string }
+  id: string
   project_name: string
   small_description: string
   skills: string[]
@@ -94,7 +94,7 @@ export const HeroParallax = ({ projects }: { projects: HeroProject[] }) => {
             <ProjectCard
               project={project}
               translate={transforms.translateX}
-              key={project.inline.id}
+              key={project.id}
               index={index + 1}
             />
           ))}
@@ -106,7 +106,7 @@ export const HeroParallax = ({ projects }: { projects: HeroProject[] }) => {
             <ProjectCard
               project={project}
               translate={transforms.translateXReverse}
-              key={project.inline.id}
+              key={project.id}
               index={index + 5}
             />
           ))}
@@ -118,7 +118,7 @@ export const HeroParallax = ({ projects }: { projects: HeroProject[] }) => {
             <ProjectCard
               project={project}
               translate={transforms.translateX}
-              key={project.inline.id}
+              key={project.id}
               index={index + 9}
             />
           ))}
@@ -209,7 +209,7 @@ export const HeroParallaxResponsive = ({ projects }: { projects: HeroProject[] }
             <ProjectCard
               project={project}
               translate={transforms.translateX}
-              key={project.inline.id}
+              key={project.id}
               index={index + 1}
               className="w-[450px]" // Slightly wider cards
             />
@@ -221,7 +221,7 @@ export const HeroParallaxResponsive = ({ projects }: { projects: HeroProject[] }
             <ProjectCard
               project={project}
               translate={transforms.translateXReverse}
-              key={project.inline.id}
+              key={project.id}
               index={index + 4}
               className="w-[450px]"
             />
@@ -233,7 +233,7 @@ export const HeroParallaxResponsive = ({ projects }: { projects: HeroProject[] }
             <ProjectCard
               project={project}
               translate={transforms.translateX}
-              key={project.inline.id}
+              key={project.id}
               index={index + 7}
               className="w-[450px]"
             />
@@ -274,7 +274,7 @@ export const ProjectCard = React.memo(
           y: -15,
           transition: { duration: 0.2, ease: 'easeOut' },
         }}
-        key={project.inline.id}
+        key={project.id}
         className={`group/product h-[500px] ${className} relative shrink-0`}
       >
         {/* Rest of the ProjectCard implementation stays the same */}
@@ -355,7 +355,7 @@ export const ProjectCard = React.memo(
                 )}
               </div>
 
-              <Link href={`/projects/${project.inline.id}`} className="w-full">
+              <Link href={`/projects/${project.id}`} className="w-full">
                 <Button
                   variant="ghost"
                   size="sm"
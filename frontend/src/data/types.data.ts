@@ -60,7 +60,7 @@ export interface AuthRequest {
 export interface AuthResponse {
   token: string
   data: {
-    _id: string
+    id: string
     email: string
     skills: string[]
     projects: string[]
@@ -87,14 +87,10 @@ export interface SkillsResponse {
 }
 
 export interface ProfileData {
-  inline: {
-    id: string
-    created_at: string
-    updated_at: string
-  }
+  id: string
+  created_at: string
+  updated_at: string
   email: string
-  password: string
-  admin_pass: string
   skills: string[]
   projects: string[]
   experiences: string[]
@@ -107,7 +103,6 @@ export interface Project {
   stats: any
   id: any
   images: never[]
-  inline: any
   project_name: string
   small_description: string
   description: string
@@ -133,7 +128,7 @@ export type UpdateProjectRequest = CreateProjectRequest
 
 // Experience Types
 export interface Experience {
-  inline: any
+  id: string
   company_name: string
   position: string
   start_date: string
@@ -165,11 +160,9 @@ export type UpdateExperienceRequest = CreateExperienceRequest
 
 // Certification Types
 export interface Certification {
-  inline: {
-    id: string
-    created_at: string
-    updated_at: string
-  }
+  id: string
+  created_at?: string
+  updated_at?: string
   title: string
   description: string
   projects: string[]